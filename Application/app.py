@@ -57,7 +57,7 @@ def main():
             if st.session_state.detected_conditions:
                 st.subheader("Conditions detected:", anchor=False)
                 for condition in st.session_state.detected_conditions:
-                    st.write(f"- {condition}")
+                    st.write(f"- {condition['label']}")
 
         #Product recommendations section
         if st.session_state.detected_conditions:
@@ -19,44 +19,28 @@ def detect_objects(model, image):
 
 def analyze_skin(file):
     img, img_rgb = preprocess_image(file)
-    pigmentation_boxes = detect_objects(pigmentation_model, img_rgb)
-    darkspot_boxes = detect_objects(darkspot_model, img_rgb)
-    acne_boxes = detect_objects(acne_model, img_rgb)
+    models = [
+        ("Pigmentation", pigmentation_model),
+        ("Dark Spots", darkspot_model),
+        ("Acne", acne_model),
+    ]
 
+    # Each detection carries its box count and best confidence, which the
+    # backend uses to rank recommendations
     detected_conditions = []
-    if len(pigmentation_boxes) > 0:
-        detected_conditions.append("Pigmentation")
-    if len(darkspot_boxes) > 0:
-        detected_conditions.append("Dark Spots")
-    if len(acne_boxes) > 0:
-        detected_conditions.append("Acne")
+    for label, model in models:
+        boxes = detect_objects(model, img_rgb)
+        if len(boxes) > 0:
+            detected_conditions.append({
+                "label": label,
+                "confidence": float(boxes[:, 4].max()),
+                "boxes": len(boxes),
+            })
     return detected_conditions
 
 
 def get_recommended_products(detected_conditions, skin_type_id):
-    API_BASE_URL = "https://clear-vision-438804-u6.el.r.appspot.com"
-    PRODUCTS_BY_CONCERN_ENDPOINT = "/products/select"
-    recommended_products = []
-
-    for condition in detected_conditions:
-        # Map condition to concern ID
-        concern_id = None
-        if condition == "Acne":
-            concern_id = 1
-        elif condition == "Pigmentation" or condition == "Dark Spots":
-            concern_id = 2
-        else:
-            continue
-
-        # Fetch products based on both concern ID and skin type
-        url = f"{API_BASE_URL}{PRODUCTS_BY_CONCERN_ENDPOINT}/{concern_id}/{skin_type_id}"
-        try:
-            products_by_concern_data = fetch_data(url)
-            if products_by_concern_data:  # Check if data is not empty
-                recommended_products.extend(products_by_concern_data)
-        except requests.exceptions.RequestException as e:
-            print(f"Error fetching products: {e}")
-            continue
+    recommended_products = fetch_recommendations(detected_conditions, skin_type_id)
     # Return recommended products if found, otherwise indicate no products
     if recommended_products:
         return recommended_products
@@ -64,36 +48,31 @@ def get_recommended_products(detected_conditions, skin_type_id):
         return "No products for given condition and skin type."
 
 def get_recommended_products_by_type(detected_conditions, skin_type_id, product_type_id):
-    API_BASE_URL = "https://clear-vision-438804-u6.el.r.appspot.com"
-    PRODUCTS_BY_CONCERN_ENDPOINT = "/products/selectspec"
-    recommended_products = []
-
-    for condition in detected_conditions:
-        # Map condition to concern ID
-        concern_id = None
-        if condition == "Acne":
-            concern_id = 1
-        elif condition == "Pigmentation" or condition == "Dark Spots":
-            concern_id = 2
-        else:
-            continue
-
-        # Fetch products based on concern ID, skin type, and product type
-        url = f"{API_BASE_URL}{PRODUCTS_BY_CONCERN_ENDPOINT}/{concern_id}/{skin_type_id}/{product_type_id}"
-        try:
-            products_by_concern_data = fetch_data(url)
-            if products_by_concern_data:  # Check if data is not empty
-                recommended_products.extend(products_by_concern_data)
-        except requests.exceptions.RequestException as e:
-            print(f"Error fetching products: {e}")
-            continue
+    recommended_products = fetch_recommendations(detected_conditions, skin_type_id, product_type_id)
     # Return recommended products if found, otherwise indicate no products
     if recommended_products:
         return recommended_products
     else:
         return "No products for given condition, skin type, and product type."
 
-def fetch_data(url):
-    response = requests.get(url)
-    response.raise_for_status()  # Raise exception for bad status codes
-    return response.json()
\ No newline at end of file
+# Sensitive skin only gets products with none of the irritants the backend
+# knows about; unknown ingredients are not vouched for
+SENSITIVE_SKIN_TYPE_ID = 5
+SENSITIVE_SKIN_BADGES = ["fragrance-free", "essential-oil-free", "alcohol-free"]
+
+def fetch_recommendations(detected_conditions, skin_type_id, product_type_id=None):
+    # The backend maps condition labels to concerns and ranks the products
+    API_BASE_URL = "https://clear-vision-438804-u6.el.r.appspot.com"
+    RECOMMENDATIONS_ENDPOINT = "/recommendations"
+    body = {"conditions": detected_conditions, "skin_type_id": skin_type_id}
+    if product_type_id is not None:
+        body["product_type_id"] = product_type_id
+    if int(skin_type_id) == SENSITIVE_SKIN_TYPE_ID:
+        body["badges"] = SENSITIVE_SKIN_BADGES
+    try:
+        response = requests.post(f"{API_BASE_URL}{RECOMMENDATIONS_ENDPOINT}", json=body)
+        response.raise_for_status()  # Raise exception for bad status codes
+        return response.json()["data"]
+    except requests.exceptions.RequestException as e:
+        print(f"Error fetching products: {e}")
+        return []
@@ -0,0 +1,187 @@
+package api_error
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/go-sql-driver/mysql"
+	"log"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+	"net/http"
+)
+
+// Error codes. Clients may rely on these; messages are for humans only.
+const (
+	CodeInvalidRequest   = "invalid_request"   // 400: the request could not be read
+	CodeNotFound         = "not_found"         // 404: no row or route matches
+	CodeConflict         = "conflict"          // 409: a row with the same key exists
+	CodeFKViolation      = "fk_violation"      // 409 on delete, 422 on write: a foreign key would break
+	CodeValidationFailed = "validation_failed" // 422: fields break the entity's rules
+	CodeInternal         = "internal"          // 500: details are logged, not returned
+)
+
+// Field error codes
+const (
+	Required         = "required"
+	TooLong          = "too_long"
+	Invalid          = "invalid"
+	InvalidURL       = "invalid_url"
+	UnknownReference = "unknown_reference"
+)
+
+// MySQL server error numbers translated by Respond
+const (
+	mysqlDuplicateEntry  = 1062
+	mysqlRowIsReferenced = 1451
+	mysqlNoReferencedRow = 1452
+)
+
+// requestIDKey is the gin context key holding the request ID.
+const requestIDKey = "request_id"
+
+// FieldError describes one problem with one request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error is an error reported to API clients. It is written as
+//
+//	{"error": {"code": "...", "message": "...", "request_id": "...", "fields": [...]}}
+//
+// with Status as the HTTP status code.
+type Error struct {
+	Status    int          `json:"-"`
+	Code      string       `json:"code"`
+	Message   string       `json:"message"`
+	RequestID string       `json:"request_id"`
+	Fields    []FieldError `json:"fields,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// BadRequest reports a request that could not be read, such as malformed JSON
+// or a non-numeric ID.
+func BadRequest(message string, fields ...FieldError) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: CodeInvalidRequest, Message: message, Fields: fields}
+}
+
+// InvalidParam reports a path or query parameter that is not a valid value.
+func InvalidParam(name string) *Error {
+	return BadRequest("Invalid "+name, FieldError{Field: name, Code: Invalid, Message: "Invalid " + name})
+}
+
+// ValidationFailed reports a well-formed request whose fields break the
+// entity's rules. Every problem found is listed.
+func ValidationFailed(fields []FieldError) *Error {
+	return &Error{
+		Status:  http.StatusUnprocessableEntity,
+		Code:    CodeValidationFailed,
+		Message: "One or more fields are invalid",
+		Fields:  fields,
+	}
+}
+
+// NotFound reports that the addressed row does not exist.
+func NotFound(message string) *Error {
+	return &Error{Status: http.StatusNotFound, Code: CodeNotFound, Message: message}
+}
+
+// Conflict reports that a row with the same key already exists.
+func Conflict(message string) *Error {
+	return &Error{Status: http.StatusConflict, Code: CodeConflict, Message: message}
+}
+
+// Respond writes err in the error envelope. Database errors with a known
+// meaning are translated to their client-facing code; anything else is logged
+// with the request ID and reported as an internal error without details.
+func Respond(c *gin.Context, err error) {
+	apiErr := translate(c, err)
+	if apiErr.Status >= http.StatusInternalServerError {
+		log.Printf("[%s] %s %s: %v", RequestID(c), c.Request.Method, c.Request.URL.Path, err)
+	}
+	// Copy so that shared errors never carry another request's ID.
+	response := *apiErr
+	response.RequestID = RequestID(c)
+	c.AbortWithStatusJSON(response.Status, gin.H{"error": response})
+}
+
+func translate(c *gin.Context, err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return NotFound("Not found")
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case mysqlDuplicateEntry:
+			return Conflict("A record with this ID already exists")
+		case mysqlRowIsReferenced:
+			return referenced()
+		case mysqlNoReferencedRow:
+			return danglingReference()
+		}
+	}
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY, sqlite3.SQLITE_CONSTRAINT_UNIQUE:
+			return Conflict("A record with this ID already exists")
+		case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+			// SQLite does not say which side of the key failed; a delete can
+			// only break it by removing a referenced row.
+			if c.Request.Method == http.MethodDelete {
+				return referenced()
+			}
+			return danglingReference()
+		}
+	}
+	return &Error{Status: http.StatusInternalServerError, Code: CodeInternal, Message: "Internal server error"}
+}
+
+func referenced() *Error {
+	return &Error{Status: http.StatusConflict, Code: CodeFKViolation, Message: "The record is still referenced by other records"}
+}
+
+func danglingReference() *Error {
+	return &Error{Status: http.StatusUnprocessableEntity, Code: CodeFKViolation, Message: "The record references a row that does not exist"}
+}
+
+// RequestIDMiddleware tags every request with an ID, reusing the client's
+// X-Request-ID header when present, and echoes it in the response headers.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader("X-Request-ID")
+		if id == "" || len(id) > 64 {
+			buf := make([]byte, 8)
+			rand.Read(buf)
+			id = hex.EncodeToString(buf)
+		}
+		c.Set(requestIDKey, id)
+		c.Header("X-Request-ID", id)
+		c.Next()
+	}
+}
+
+// RequestID returns the ID assigned by RequestIDMiddleware.
+func RequestID(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+// Recovery reports a panicking handler as an internal error in the envelope.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
+		Respond(c, fmt.Errorf("panic: %v", recovered))
+	})
+}
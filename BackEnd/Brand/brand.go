@@ -1,7 +1,10 @@
 package brand
 
 import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
 	"database/sql"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
@@ -13,88 +16,161 @@ type Brand struct {
 }
 
 // Get all brands
-func GetBrands(c *gin.Context, db *sql.DB) {
-	rows, err := db.Query("SELECT Brand_ID, Brand FROM Brand")
+func GetBrands(c *gin.Context, store Store) {
+	brands, err := store.List()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	defer rows.Close()
-	var brands []Brand
-	for rows.Next() {
-		var brand Brand
-		if err := rows.Scan(&brand.BrandID, &brand.Brand); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		brands = append(brands, brand)
-	}
 	c.JSON(http.StatusOK, brands)
 }
 
+// Get a brand by ID
+func GetBrand(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("brand_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("brand_id"))
+		return
+	}
+	brand, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, brand)
+}
+
 // Create a new brand
-func CreateBrand(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	brandIDStr := c.Query("brand_id")
-	brand := c.Query("brand")
-	// Convert brandID to int (since it will be a string from the query)
-	brandID, err := strconv.Atoi(brandIDStr)
+func CreateBrand(c *gin.Context, store Store) {
+	newBrand, err := bindBrand(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brand_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the new brand struct
-	newBrand := Brand{
-		BrandID: brandID,
-		Brand:   brand,
+	// Validate before writing
+	if fields := Validate(newBrand); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
 	}
-	// Execute the SQL statement with parameters
-	result, err := db.Exec("INSERT INTO Brand (Brand_ID, Brand) VALUES (?, ?);", newBrand.BrandID, newBrand.Brand)
+	// Persist the brand
+	newBrand, err = store.Create(newBrand)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Retrieve and set the last inserted ID if needed
-	id, _ := result.LastInsertId()
-	newBrand.BrandID = int(id)
-	// Send the response
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/brand/%d", newBrand.BrandID))
 	c.JSON(http.StatusCreated, newBrand)
 }
 
 // Update an existing brand
-func UpdateBrand(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	brandIDStr := c.Query("brand_id")
-	brand := c.Query("brand")
+func UpdateBrand(c *gin.Context, store Store) {
+	updatedBrand, err := bindBrand(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := ValidateReplacement(updatedBrand); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedBrand)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedBrand.BrandID))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
 
-	// Convert brandID to an integer
-	brandID, err := strconv.Atoi(brandIDStr)
+// Update only the supplied fields of a brand
+func PatchBrand(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("brand_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("brand_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brand_id"})
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("brand_id", patched.BrandID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
 		return
 	}
-	// Prepare the updated brand struct
-	updatedBrand := Brand{
-		BrandID: brandID,
-		Brand:   brand,
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
 	}
-	// Execute the SQL statement with parameters
-	_, err = db.Exec("UPDATE Brand SET Brand = ? WHERE Brand_ID = ?", updatedBrand.Brand, updatedBrand.BrandID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Send the response
-	c.JSON(http.StatusOK, updatedBrand)
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
 }
 
 // Delete a brand
-func DeleteBrand(c *gin.Context, db *sql.DB) {
-	id := c.Param("brand_id")
-	_, err := db.Exec("DELETE FROM Brand WHERE Brand_ID = ?", id)
+func DeleteBrand(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("brand_id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, api_error.InvalidParam("brand_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Brand deleted"})
 }
+
+// bindBrand reads a brand from the JSON request body. Requests without one
+// fall back to the deprecated brand_id and brand query parameters.
+func bindBrand(c *gin.Context) (Brand, error) {
+	var brand Brand
+	if request.HasJSONBody(c) {
+		err := request.BindJSON(c, &brand)
+		return brand, err
+	}
+	request.DeprecatedQuery(c)
+	if err := request.QueryInts(c, request.IntParam{Name: "brand_id", Dest: &brand.BrandID, Optional: true}); err != nil {
+		return brand, err
+	}
+	brand.Brand = c.Query("brand")
+	return brand, nil
+}
+
+// notFound reports an unknown Brand_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Brand %d not found", id))
+}
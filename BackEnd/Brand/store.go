@@ -0,0 +1,152 @@
+package brand
+
+import (
+	"BackEnd/Database"
+	"database/sql"
+	"sort"
+	"sync"
+)
+
+// Store is the persistence boundary for brands. Lookups, updates and deletes of
+// an unknown Brand_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned.
+type Store interface {
+	List() ([]Brand, error)
+	Get(id int) (Brand, error)
+	Create(brand Brand) (Brand, error)
+	Update(brand Brand) (Brand, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Brand"
+
+var Columns = []string{"Brand_ID", "Brand"}
+
+// SQLStore keeps brands in the Brand table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]Brand, error) {
+	rows, err := s.db.Query("SELECT Brand_ID, Brand FROM Brand")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var brands []Brand
+	for rows.Next() {
+		var brand Brand
+		if err := rows.Scan(&brand.BrandID, &brand.Brand); err != nil {
+			return nil, err
+		}
+		brands = append(brands, brand)
+	}
+	return brands, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (Brand, error) {
+	var brand Brand
+	err := s.db.QueryRow("SELECT Brand_ID, Brand FROM Brand WHERE Brand_ID = ?", id).
+		Scan(&brand.BrandID, &brand.Brand)
+	return brand, err
+}
+
+func (s *SQLStore) Create(brand Brand) (Brand, error) {
+	result, err := s.db.Exec("INSERT INTO Brand (Brand) VALUES (?)", brand.Brand)
+	if err != nil {
+		return Brand{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Brand{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(brand Brand) (Brand, error) {
+	result, err := s.db.Exec("UPDATE Brand SET Brand = ? WHERE Brand_ID = ?", brand.Brand, brand.BrandID)
+	if err != nil {
+		return Brand{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return Brand{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(brand.BrandID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Brand WHERE Brand_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps brands in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]Brand
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]Brand)}
+}
+
+func (s *MemoryStore) List() ([]Brand, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var brands []Brand
+	for _, brand := range s.rows {
+		brands = append(brands, brand)
+	}
+	sort.Slice(brands, func(i, j int) bool { return brands[i].BrandID < brands[j].BrandID })
+	return brands, nil
+}
+
+func (s *MemoryStore) Get(id int) (Brand, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	brand, ok := s.rows[id]
+	if !ok {
+		return Brand{}, sql.ErrNoRows
+	}
+	return brand, nil
+}
+
+func (s *MemoryStore) Create(brand Brand) (Brand, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	brand.BrandID = s.lastID
+	s.rows[brand.BrandID] = brand
+	return brand, nil
+}
+
+func (s *MemoryStore) Update(brand Brand) (Brand, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[brand.BrandID]; !ok {
+		return Brand{}, sql.ErrNoRows
+	}
+	s.rows[brand.BrandID] = brand
+	return brand, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
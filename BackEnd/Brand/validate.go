@@ -0,0 +1,27 @@
+package brand
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+)
+
+// Width of the Brand column
+const maxNameLength = 50
+
+// Validate checks a brand before it is written and returns every problem
+// found, or nil. New rows are numbered by the database, so the brand_id
+// is only checked by ValidateReplacement.
+func Validate(brand Brand) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("brand", brand.Brand)
+	v.MaxLength("brand", brand.Brand, maxNameLength)
+	return v.Errors()
+}
+
+// ValidateReplacement checks a brand sent to replace a stored row,
+// which must also name the row's brand_id.
+func ValidateReplacement(brand Brand) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("brand_id", brand.BrandID)
+	return append(v.Errors(), Validate(brand)...)
+}
@@ -1,99 +1,180 @@
 package concern
 
 import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
 	"database/sql"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
 )
 
+// Concern is one skin concern. A wildcard concern, such as Multipurpose,
+// applies to every concern, so the selection endpoints can offer its
+// products for any of them.
 type Concern struct {
 	ConcernID int    `json:"concern_id"`
 	Concern   string `json:"concern"`
+	Wildcard  bool   `json:"wildcard"`
 }
 
 // Get all concerns
-func GetConcerns(c *gin.Context, db *sql.DB) {
-	rows, err := db.Query("SELECT Concern_ID, Concern FROM Concern")
+func GetConcerns(c *gin.Context, store Store) {
+	concerns, err := store.List()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	defer rows.Close()
-	var concerns []Concern
-	for rows.Next() {
-		var concern Concern
-		if err := rows.Scan(&concern.ConcernID, &concern.Concern); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		concerns = append(concerns, concern)
-	}
 	c.JSON(http.StatusOK, concerns)
 }
 
+// Get a concern by ID
+func GetConcern(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("concern_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("concern_id"))
+		return
+	}
+	concern, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, concern)
+}
+
 // Create a new concern
-func CreateConcern(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	concernIDStr := c.Query("concern_id")
-	concern := c.Query("concern")
-	// Convert concernID to int (since it will be a string from the query)
-	concernID, err := strconv.Atoi(concernIDStr)
+func CreateConcern(c *gin.Context, store Store) {
+	newConcern, err := bindConcern(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid concern_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the new concern struct
-	newConcern := Concern{
-		ConcernID: concernID,
-		Concern:   concern,
+	// Validate before writing
+	if fields := Validate(newConcern); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
 	}
-	// Execute the SQL statement with parameters
-	result, err := db.Exec("INSERT INTO Concern (Concern_ID, Concern) VALUES (?, ?);", newConcern.ConcernID, newConcern.Concern)
+	// Persist the concern
+	newConcern, err = store.Create(newConcern)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Retrieve and set the last inserted ID if needed
-	id, _ := result.LastInsertId()
-	newConcern.ConcernID = int(id)
-	// Send the response
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/concerns/%d", newConcern.ConcernID))
 	c.JSON(http.StatusCreated, newConcern)
 }
 
 // Update a concern
-func UpdateConcern(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	concernIDStr := c.Query("concern_id")
-	concern := c.Query("concern")
-	// Convert concernID to an integer
-	concernID, err := strconv.Atoi(concernIDStr)
+func UpdateConcern(c *gin.Context, store Store) {
+	updatedConcern, err := bindConcern(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid concern_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the updated concern struct
-	updatedConcern := Concern{
-		ConcernID: concernID,
-		Concern:   concern,
+	// Validate before writing
+	if fields := ValidateReplacement(updatedConcern); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedConcern)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedConcern.ConcernID))
+		return
 	}
-	// Execute the SQL statement with parameters
-	_, err = db.Exec("UPDATE Concern SET Concern = ? WHERE Concern_ID = ?", updatedConcern.Concern, updatedConcern.ConcernID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Send the response
-	c.JSON(http.StatusOK, updatedConcern)
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of a concern
+func PatchConcern(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("concern_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("concern_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("concern_id", patched.ConcernID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
 }
 
 // Delete a concern
-func DeleteConcern(c *gin.Context, db *sql.DB) {
-	id := c.Param("concern_id")
-	_, err := db.Exec("DELETE FROM Concern WHERE Concern_ID = ?", id)
+func DeleteConcern(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("concern_id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, api_error.InvalidParam("concern_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Concern deleted"})
 }
+
+// bindConcern reads a concern from the JSON request body. Requests without one
+// fall back to the deprecated concern_id and concern query parameters.
+func bindConcern(c *gin.Context) (Concern, error) {
+	var concern Concern
+	if request.HasJSONBody(c) {
+		err := request.BindJSON(c, &concern)
+		return concern, err
+	}
+	request.DeprecatedQuery(c)
+	if err := request.QueryInts(c, request.IntParam{Name: "concern_id", Dest: &concern.ConcernID, Optional: true}); err != nil {
+		return concern, err
+	}
+	concern.Concern = c.Query("concern")
+	return concern, nil
+}
+
+// notFound reports an unknown Concern_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Concern %d not found", id))
+}
@@ -0,0 +1,153 @@
+package concern
+
+import (
+	"BackEnd/Database"
+	"database/sql"
+	"sort"
+	"sync"
+)
+
+// Store is the persistence boundary for concerns. Lookups, updates and deletes of
+// an unknown Concern_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned.
+type Store interface {
+	List() ([]Concern, error)
+	Get(id int) (Concern, error)
+	Create(concern Concern) (Concern, error)
+	Update(concern Concern) (Concern, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Concern"
+
+var Columns = []string{"Concern_ID", "Concern", "Is_Wildcard"}
+
+// SQLStore keeps concerns in the Concern table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]Concern, error) {
+	rows, err := s.db.Query("SELECT Concern_ID, Concern, Is_Wildcard FROM Concern")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var concerns []Concern
+	for rows.Next() {
+		var concern Concern
+		if err := rows.Scan(&concern.ConcernID, &concern.Concern, &concern.Wildcard); err != nil {
+			return nil, err
+		}
+		concerns = append(concerns, concern)
+	}
+	return concerns, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (Concern, error) {
+	var concern Concern
+	err := s.db.QueryRow("SELECT Concern_ID, Concern, Is_Wildcard FROM Concern WHERE Concern_ID = ?", id).
+		Scan(&concern.ConcernID, &concern.Concern, &concern.Wildcard)
+	return concern, err
+}
+
+func (s *SQLStore) Create(concern Concern) (Concern, error) {
+	result, err := s.db.Exec("INSERT INTO Concern (Concern, Is_Wildcard) VALUES (?, ?)", concern.Concern, concern.Wildcard)
+	if err != nil {
+		return Concern{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return Concern{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(concern Concern) (Concern, error) {
+	result, err := s.db.Exec("UPDATE Concern SET Concern = ?, Is_Wildcard = ? WHERE Concern_ID = ?",
+		concern.Concern, concern.Wildcard, concern.ConcernID)
+	if err != nil {
+		return Concern{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return Concern{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(concern.ConcernID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Concern WHERE Concern_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps concerns in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]Concern
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]Concern)}
+}
+
+func (s *MemoryStore) List() ([]Concern, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var concerns []Concern
+	for _, concern := range s.rows {
+		concerns = append(concerns, concern)
+	}
+	sort.Slice(concerns, func(i, j int) bool { return concerns[i].ConcernID < concerns[j].ConcernID })
+	return concerns, nil
+}
+
+func (s *MemoryStore) Get(id int) (Concern, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	concern, ok := s.rows[id]
+	if !ok {
+		return Concern{}, sql.ErrNoRows
+	}
+	return concern, nil
+}
+
+func (s *MemoryStore) Create(concern Concern) (Concern, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	concern.ConcernID = s.lastID
+	s.rows[concern.ConcernID] = concern
+	return concern, nil
+}
+
+func (s *MemoryStore) Update(concern Concern) (Concern, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[concern.ConcernID]; !ok {
+		return Concern{}, sql.ErrNoRows
+	}
+	s.rows[concern.ConcernID] = concern
+	return concern, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
@@ -0,0 +1,27 @@
+package concern
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+)
+
+// Width of the Concern column
+const maxNameLength = 100
+
+// Validate checks a concern before it is written and returns every problem
+// found, or nil. New rows are numbered by the database, so the concern_id
+// is only checked by ValidateReplacement.
+func Validate(concern Concern) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("concern", concern.Concern)
+	v.MaxLength("concern", concern.Concern, maxNameLength)
+	return v.Errors()
+}
+
+// ValidateReplacement checks a concern sent to replace a stored row,
+// which must also name the row's concern_id.
+func ValidateReplacement(concern Concern) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("concern_id", concern.ConcernID)
+	return append(v.Errors(), Validate(concern)...)
+}
@@ -0,0 +1,209 @@
+package condition_concern
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Concern"
+	"BackEnd/Request"
+	"database/sql"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ConditionConcern maps a condition label reported by the skin analysis
+// model, such as "Dark Spots", to the concern products are selected for.
+type ConditionConcern struct {
+	ConditionID int    `json:"condition_id"`
+	Label       string `json:"label"`
+	ConcernID   int    `json:"concern_id"`
+}
+
+// Get all condition mappings
+func GetConditions(c *gin.Context, store Store) {
+	conditions, err := store.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, conditions)
+}
+
+// Get a condition mapping by ID
+func GetCondition(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("condition_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("condition_id"))
+		return
+	}
+	condition, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, condition)
+}
+
+// Create a new condition mapping
+func CreateCondition(c *gin.Context, store Store, concerns concern.Store) {
+	newCondition, err := bindCondition(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	fields, err := Validate(newCondition, concerns)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the mapping
+	newCondition, err = store.Create(newCondition)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/condition_concerns/%d", newCondition.ConditionID))
+	c.JSON(http.StatusCreated, newCondition)
+}
+
+// Update a condition mapping
+func UpdateCondition(c *gin.Context, store Store, concerns concern.Store) {
+	updatedCondition, err := bindCondition(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	fields, err := ValidateReplacement(updatedCondition, concerns)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedCondition)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedCondition.ConditionID))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of a condition mapping
+func PatchCondition(c *gin.Context, store Store, concerns concern.Store) {
+	id, err := strconv.Atoi(c.Param("condition_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("condition_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("condition_id", patched.ConditionID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	fields, err := Validate(patched, concerns)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Delete a condition mapping
+func DeleteCondition(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("condition_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("condition_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Condition mapping deleted"})
+}
+
+// bindCondition reads a condition mapping from the JSON request body. Like
+// the synonym endpoints, these have no query parameter form.
+func bindCondition(c *gin.Context) (ConditionConcern, error) {
+	var condition ConditionConcern
+	if !request.HasJSONBody(c) {
+		return condition, api_error.BadRequest("Request body must be JSON")
+	}
+	err := request.BindJSON(c, &condition)
+	return condition, err
+}
+
+// notFound reports an unknown Condition_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Condition mapping %d not found", id))
+}
+
+// Lookup finds the mapping for label among mappings. Labels compare without
+// regard to case, and underscores, hyphens and repeated spaces count as one
+// space, so "dark_spots" finds "Dark Spots".
+func Lookup(mappings []ConditionConcern, label string) (ConditionConcern, bool) {
+	label = normalizeLabel(label)
+	for _, mapping := range mappings {
+		if normalizeLabel(mapping.Label) == label {
+			return mapping, true
+		}
+	}
+	return ConditionConcern{}, false
+}
+
+func normalizeLabel(label string) string {
+	label = strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToLower(label))
+	return strings.Join(strings.Fields(label), " ")
+}
@@ -0,0 +1,173 @@
+package condition_concern
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Database"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Store is the persistence boundary for condition mappings. Lookups, updates and deletes of
+// an unknown Condition_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned. Labels are
+// unique regardless of case.
+type Store interface {
+	List() ([]ConditionConcern, error)
+	Get(id int) (ConditionConcern, error)
+	Create(condition ConditionConcern) (ConditionConcern, error)
+	Update(condition ConditionConcern) (ConditionConcern, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Condition_Concern"
+
+var Columns = []string{"Condition_ID", "Label", "Concern_ID"}
+
+// SQLStore keeps condition mappings in the Condition_Concern table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]ConditionConcern, error) {
+	rows, err := s.db.Query("SELECT Condition_ID, Label, Concern_ID FROM Condition_Concern ORDER BY Condition_ID")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var conditions []ConditionConcern
+	for rows.Next() {
+		var condition ConditionConcern
+		if err := rows.Scan(&condition.ConditionID, &condition.Label, &condition.ConcernID); err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (ConditionConcern, error) {
+	var condition ConditionConcern
+	err := s.db.QueryRow("SELECT Condition_ID, Label, Concern_ID FROM Condition_Concern WHERE Condition_ID = ?", id).
+		Scan(&condition.ConditionID, &condition.Label, &condition.ConcernID)
+	return condition, err
+}
+
+func (s *SQLStore) Create(condition ConditionConcern) (ConditionConcern, error) {
+	result, err := s.db.Exec("INSERT INTO Condition_Concern (Label, Concern_ID) VALUES (?, ?)", condition.Label, condition.ConcernID)
+	if err != nil {
+		return ConditionConcern{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return ConditionConcern{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(condition ConditionConcern) (ConditionConcern, error) {
+	result, err := s.db.Exec("UPDATE Condition_Concern SET Label = ?, Concern_ID = ? WHERE Condition_ID = ?",
+		condition.Label, condition.ConcernID, condition.ConditionID)
+	if err != nil {
+		return ConditionConcern{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return ConditionConcern{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(condition.ConditionID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Condition_Concern WHERE Condition_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps condition mappings in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]ConditionConcern
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]ConditionConcern)}
+}
+
+func (s *MemoryStore) List() ([]ConditionConcern, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var conditions []ConditionConcern
+	for _, condition := range s.rows {
+		conditions = append(conditions, condition)
+	}
+	sort.Slice(conditions, func(i, j int) bool { return conditions[i].ConditionID < conditions[j].ConditionID })
+	return conditions, nil
+}
+
+func (s *MemoryStore) Get(id int) (ConditionConcern, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	condition, ok := s.rows[id]
+	if !ok {
+		return ConditionConcern{}, sql.ErrNoRows
+	}
+	return condition, nil
+}
+
+func (s *MemoryStore) Create(condition ConditionConcern) (ConditionConcern, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkUnique(condition); err != nil {
+		return ConditionConcern{}, err
+	}
+	s.lastID++
+	condition.ConditionID = s.lastID
+	s.rows[condition.ConditionID] = condition
+	return condition, nil
+}
+
+func (s *MemoryStore) Update(condition ConditionConcern) (ConditionConcern, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[condition.ConditionID]; !ok {
+		return ConditionConcern{}, sql.ErrNoRows
+	}
+	if err := s.checkUnique(condition); err != nil {
+		return ConditionConcern{}, err
+	}
+	s.rows[condition.ConditionID] = condition
+	return condition, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
+
+// checkUnique mirrors the UNIQUE constraint on Label. Callers must hold s.mu.
+func (s *MemoryStore) checkUnique(condition ConditionConcern) error {
+	for id, existing := range s.rows {
+		if id != condition.ConditionID && strings.EqualFold(existing.Label, condition.Label) {
+			return api_error.Conflict(fmt.Sprintf("Label %q already exists", condition.Label))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package condition_concern
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Concern"
+	"BackEnd/Validation"
+)
+
+// Width of the Label column
+const maxLabelLength = 100
+
+// Validate checks a condition mapping before it is written and returns every
+// problem found, or nil. The error is non-nil only if the concern lookup
+// failed. New rows are numbered by the database, so the condition_id is only
+// checked by ValidateReplacement.
+func Validate(condition ConditionConcern, concerns concern.Store) ([]api_error.FieldError, error) {
+	var v validation.Validator
+	v.Required("label", condition.Label)
+	v.MaxLength("label", condition.Label, maxLabelLength)
+	err := v.Reference("concern_id", condition.ConcernID, func(id int) error { _, err := concerns.Get(id); return err })
+	if err != nil {
+		return nil, err
+	}
+	return v.Errors(), nil
+}
+
+// ValidateReplacement checks a condition mapping sent to replace a stored
+// row, which must also name the row's condition_id.
+func ValidateReplacement(condition ConditionConcern, concerns concern.Store) ([]api_error.FieldError, error) {
+	var v validation.Validator
+	v.Positive("condition_id", condition.ConditionID)
+	fields, err := Validate(condition, concerns)
+	if err != nil {
+		return nil, err
+	}
+	return append(v.Errors(), fields...), nil
+}
@@ -0,0 +1,339 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Dialect names the SQL flavour spoken by a connection. The values match the
+// db_driver setting in config.json.
+type Dialect string
+
+const (
+	MySQL  Dialect = "mysql"
+	SQLite Dialect = "sqlite"
+)
+
+// Migration is one numbered schema change. Up applies it and Down reverts it.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// migrationFiles holds the schema history as <version>_<name>.<up|down>.sql.
+// A file named <version>_<name>.<up|down>.<dialect>.sql replaces the generic
+// one for that dialect, for the few statements MySQL and SQLite disagree on.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+var migrationName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)(?:\.(mysql|sqlite))?\.sql$`)
+
+// Migrations returns the embedded migrations for dialect in version order.
+func Migrations(dialect Dialect) ([]Migration, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int]*Migration)
+	// Generic files are read first so dialect-specific ones can replace them.
+	sort.Slice(entries, func(i, j int) bool {
+		return strings.Count(entries[i].Name(), ".") < strings.Count(entries[j].Name(), ".")
+	})
+	for _, entry := range entries {
+		match := migrationName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file %s", entry.Name())
+		}
+		if match[4] != "" && Dialect(match[4]) != dialect {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, migration.Name, match[2])
+		}
+		body, err := migrationFiles.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if match[3] == "up" {
+			migration.Up = string(body)
+		} else {
+			migration.Down = string(body)
+		}
+	}
+	var migrations []Migration
+	for _, migration := range byVersion {
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return nil, fmt.Errorf("migration %d is missing", i+1)
+		}
+	}
+	return migrations, nil
+}
+
+// Version reports the newest migration applied to db, or 0 for an empty
+// database.
+func Version(db *sql.DB, dialect Dialect) (int, error) {
+	ctx := context.Background()
+	exists, err := tableExists(ctx, db, dialect, "Schema_Version")
+	if err != nil || !exists {
+		return 0, err
+	}
+	var version int
+	err = db.QueryRowContext(ctx, "SELECT COALESCE(MAX(Version), 0) FROM Schema_Version").Scan(&version)
+	return version, err
+}
+
+// Migrate applies every pending migration in order and returns the ones it ran.
+func Migrate(db *sql.DB, dialect Dialect) ([]Migration, error) {
+	migrations, err := Migrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+	var applied []Migration
+	err = withMigrationConn(db, dialect, migrations, func(ctx context.Context, conn *sql.Conn, current int) error {
+		for _, migration := range migrations {
+			if migration.Version <= current {
+				continue
+			}
+			log.Printf("Applying migration %04d_%s", migration.Version, migration.Name)
+			err := runMigration(ctx, conn, dialect, migration.Up,
+				"INSERT INTO Schema_Version (Version, Name) VALUES (?, ?)", migration.Version, migration.Name)
+			if err != nil {
+				return fmt.Errorf("migration %04d_%s: %v", migration.Version, migration.Name, err)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Rollback reverts the newest steps migrations and returns the ones it ran.
+func Rollback(db *sql.DB, dialect Dialect, steps int) ([]Migration, error) {
+	migrations, err := Migrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+	var reverted []Migration
+	err = withMigrationConn(db, dialect, migrations, func(ctx context.Context, conn *sql.Conn, current int) error {
+		for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := migrations[i]
+			if migration.Version > current {
+				continue
+			}
+			log.Printf("Reverting migration %04d_%s", migration.Version, migration.Name)
+			err := runMigration(ctx, conn, dialect, migration.Down,
+				"DELETE FROM Schema_Version WHERE Version = ?", migration.Version)
+			if err != nil {
+				return fmt.Errorf("reverting %04d_%s: %v", migration.Version, migration.Name, err)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// withMigrationConn runs fn on a dedicated connection holding the migration
+// lock, after making sure Schema_Version exists. Session settings made by a
+// migration (such as FOREIGN_KEY_CHECKS) therefore stay on one connection.
+func withMigrationConn(db *sql.DB, dialect Dialect, migrations []Migration, fn func(ctx context.Context, conn *sql.Conn, current int) error) error {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if dialect == MySQL {
+		// Several App Engine instances can start at once; only one may migrate.
+		var locked sql.NullInt64
+		if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK('skinalyze_migrate', 60)").Scan(&locked); err != nil {
+			return err
+		}
+		if locked.Int64 != 1 {
+			return fmt.Errorf("timed out waiting for the migration lock")
+		}
+		defer conn.ExecContext(ctx, "SELECT RELEASE_LOCK('skinalyze_migrate')")
+	}
+
+	if err := ensureVersionTable(ctx, conn, dialect, migrations); err != nil {
+		return err
+	}
+	var current int
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(Version), 0) FROM Schema_Version").Scan(&current); err != nil {
+		return err
+	}
+	return fn(ctx, conn, current)
+}
+
+// ensureVersionTable creates Schema_Version. Databases built by hand from the
+// old SQL scripts already hold the catalog tables; they are recorded as being
+// at the matching migration instead of having it re-applied.
+func ensureVersionTable(ctx context.Context, conn *sql.Conn, dialect Dialect, migrations []Migration) error {
+	exists, err := tableExists(ctx, conn, dialect, "Schema_Version")
+	if err != nil || exists {
+		return err
+	}
+	legacy, err := tableExists(ctx, conn, dialect, "Products")
+	if err != nil {
+		return err
+	}
+	_, err = conn.ExecContext(ctx, `CREATE TABLE Schema_Version (Version int primary key, Name nvarchar(100) NOT NULL,
+                                    Applied_At timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP)`)
+	if err != nil || !legacy {
+		return err
+	}
+
+	// The scripts created and seeded the tables (0001, 0002); some servers
+	// also had the URL columns (0003) added by hand.
+	baseline := 2
+	columns, err := Columns(ctx, conn, dialect, "Products")
+	if err != nil {
+		return err
+	}
+	if containsFold(columns, "Product_URL") && containsFold(columns, "Image_URL") {
+		baseline = 3
+	}
+	log.Printf("Existing catalog found; recording schema as migration %04d", baseline)
+	for _, migration := range migrations[:baseline] {
+		_, err := conn.ExecContext(ctx, "INSERT INTO Schema_Version (Version, Name) VALUES (?, ?)", migration.Version, migration.Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runMigration runs script and then record, the statement that notes it in
+// Schema_Version, with args.
+//
+// On SQLite both run in one transaction, so a migration that fails leaves
+// the database as it was. Foreign keys are switched off around the
+// transaction, as rebuilding a referenced table needs, since the PRAGMA has
+// no effect inside one.
+//
+// MySQL commits each DDL statement as it runs, so a migration there cannot
+// be rolled back: if one fails, the statements before it stay applied while
+// the version is not recorded, and the database must be put right by hand
+// before migrating again.
+func runMigration(ctx context.Context, conn *sql.Conn, dialect Dialect, script, record string, args ...interface{}) error {
+	if dialect != SQLite {
+		if err := execMigration(ctx, conn, script); err != nil {
+			return err
+		}
+		_, err := conn.ExecContext(ctx, record, args...)
+		return err
+	}
+
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return err
+	}
+	defer conn.ExecContext(ctx, "PRAGMA foreign_keys = ON")
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := execMigration(ctx, tx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// execer is satisfied by *sql.Conn and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func execMigration(ctx context.Context, conn execer, script string) error {
+	for _, statement := range SplitStatements(script) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("executing %q: %v", abbreviate(statement), err)
+		}
+	}
+	return nil
+}
+
+// queryer is satisfied by *sql.DB and *sql.Conn.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// tableExists reports whether table exists, ignoring case as MySQL does on
+// servers with lower_case_table_names set.
+func tableExists(ctx context.Context, db queryer, dialect Dialect, table string) (bool, error) {
+	var query string
+	switch dialect {
+	case MySQL:
+		query = "SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = DATABASE() AND LOWER(TABLE_NAME) = LOWER(?)"
+	case SQLite:
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND LOWER(name) = LOWER(?)"
+	default:
+		return false, fmt.Errorf("unknown dialect %q", dialect)
+	}
+	var count int
+	err := db.QueryRowContext(ctx, query, table).Scan(&count)
+	return count > 0, err
+}
+
+// Columns lists the columns of table in ordinal order.
+func Columns(ctx context.Context, db queryer, dialect Dialect, table string) ([]string, error) {
+	var query string
+	switch dialect {
+	case MySQL:
+		query = `SELECT COLUMN_NAME FROM INFORMATION_SCHEMA.COLUMNS
+                 WHERE TABLE_SCHEMA = DATABASE() AND LOWER(TABLE_NAME) = LOWER(?)
+                 ORDER BY ORDINAL_POSITION`
+	case SQLite:
+		query = "SELECT name FROM pragma_table_info(?) ORDER BY cid"
+	default:
+		return nil, fmt.Errorf("unknown dialect %q", dialect)
+	}
+	rows, err := db.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	return columns, rows.Err()
+}
+
+func containsFold(values []string, want string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, want) {
+			return true
+		}
+	}
+	return false
+}
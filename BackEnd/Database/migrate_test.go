@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestFailedSQLiteMigrationLeavesNoTrace(t *testing.T) {
+	db, err := OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "CREATE TABLE Schema_Version (Version int primary key, Name nvarchar(100) NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+
+	err = runMigration(ctx, conn, SQLite, "CREATE TABLE Half_Done (ID int); INSERT INTO Missing VALUES (1);",
+		"INSERT INTO Schema_Version (Version, Name) VALUES (?, ?)", 1, "broken")
+	if err == nil {
+		t.Fatal("runMigration succeeded; want the missing table to fail it")
+	}
+	if exists, err := tableExists(ctx, conn, SQLite, "Half_Done"); err != nil || exists {
+		t.Errorf("Half_Done exists = %v (%v); want the failed migration rolled back", exists, err)
+	}
+	var versions int
+	if err := conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM Schema_Version").Scan(&versions); err != nil || versions != 0 {
+		t.Errorf("%d versions recorded (%v); want none", versions, err)
+	}
+}
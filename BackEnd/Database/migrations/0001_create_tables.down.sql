@@ -0,0 +1,6 @@
+DROP TABLE Products;
+DROP TABLE Key_Ingredients;
+DROP TABLE Product_Type;
+DROP TABLE Brand;
+DROP TABLE Skin_Type;
+DROP TABLE Concern;
@@ -1,7 +1,3 @@
-/* creating database*/
-CREATE DATABASE Skinalyze;
-USE Skinalyze;
-
 /* creating tables*/
 CREATE TABLE Concern (Concern_ID int primary key, Concern nvarchar(100));
 CREATE TABLE Skin_Type (Skin_Type_ID int primary key, Skin_Type nvarchar(100));
@@ -17,4 +13,3 @@ CREATE TABLE Products (Product_ID int primary key, Product_Name nvarchar(200),
                        FOREIGN KEY(Brand_ID) REFERENCES Brand(Brand_ID),
                        FOREIGN KEY(Product_Type_ID) REFERENCES Product_Type(Product_Type_ID),
                        FOREIGN KEY(Key_Ingredients_ID) REFERENCES Key_Ingredients(Key_Ingredients_ID));
-select * from Products;
\ No newline at end of file
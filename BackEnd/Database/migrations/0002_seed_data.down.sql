@@ -0,0 +1,6 @@
+DELETE FROM Products;
+DELETE FROM Key_Ingredients;
+DELETE FROM Product_Type;
+DELETE FROM Brand;
+DELETE FROM Skin_Type;
+DELETE FROM Concern;
@@ -1,5 +1,3 @@
-USE Skinalyze;
-
 INSERT INTO Concern VALUES(1,'Acne / Blemishes');
 INSERT INTO Concern VALUES(2,'Pigmentation / Dark Spots');
 INSERT INTO Concern VALUES(3,'Hydration / Dryness');
@@ -37,100 +35,100 @@ INSERT INTO Key_Ingredients VALUES(8,'Benzoyl-Peroxide');
 INSERT INTO Key_Ingredients VALUES(9,'Others');
 INSERT INTO Key_Ingredients VALUES(10,'Glycolic Acid');
 
-INSERT INTO PRODUCTS VALUES(1,'Acne Foaming Cream Cleanser','Benzoyl Peroxide 4%, Water, Glycerin, Propylene Glycol, Cocamidopropyl Hydroxysultaine, Sodium C14-16 Olefin Sulfonate, Xanthan Gum, Potassium Hydroxide, Ceramide NP, Ceramide AP, Ceramide EOP, Carbomer, Niacinamide, Glycolic Acid, Sodium Chloride, Sodium Citrate, Sodium Hyaluronate, Sodium Lauroyl Lactylate, Sodium Hydroxide, Cholesterol, Phenoxyethanol, Propanediol, Citric Acid, Tetrasodium EDTA, Diethylhexyl Sodium Sulfosuccinate, Phytosphingosine, Ethylhexylglycerin, Benzoic Acid',1,1,1,1,8);
-INSERT INTO PRODUCTS VALUES(2,'Acne Control Cleanser','SALICYLIC ACID 2%, WATER, SODIUM LAUROYL SARCOSINATE, COCAMIDOPROPYL HYDROXYSULTAINE, GLYCERIN, NIACINAMIDE, GLUCONOLACTONE, SODIUM METHYL COCOYL TAURATE, PEG-150 PENTAERYTHRITYL TETRASTEARATE, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, CALCIUM GLUCONATE, TRIETHYL CITRATE, SODIUM BENZOATE, SODIUM HYDROXIDE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, TETRASODIUM EDTA, CAPRYLYL GLYCOL, HYDROLYZED HYALURONIC ACID, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, XANTHAN GUM, HECTORITE, PHYTOSPHINGOSINE, BENZOIC ACID',1,1,1,1,1);
-INSERT INTO PRODUCTS VALUES(3,'Acne Foaming Cream Wash','BENZOYL PEROXIDE 10%, WATER, GLYCERIN, PROPYLENE GLYCOL, COCAMIDOPROPYL HYDROXYSULTAINE, SODIUM C14-16 OLEFIN SULFONATE, POTASSIUM HYDROXIDE, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, NIACINAMIDE, GLYCOLIC ACID, TRIDECETH-6, TRIETHYL CITRATE, SODIUM CITRATE, SODIUM HYALURONATE, SODIUM HYDROXIDE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, PROPANEDIOL, TETRASODIUM EDTA, CAPRYLYL GLYCOL, DIETHYLHEXYL SODIUM SULFOSUCCINATE, PHYTOSPHINGOSINE, XANTHAN GUM, ACRYLATES/C10-30 ALKYL ACRYLATE CROSSPOLYMER, BENZOIC ACID, PEG-30 DIPOLYHYDROXYSTEARATE',1,1,1,1,8);
-INSERT INTO PRODUCTS VALUES(4,'AM Facial Moisturizing Lotion SPF 30','HOMOSALATE (10%), MERADIMATE (5%), OCTINOXATE (5%), OCTOCRYLENE (2%),  ZINC OXIDE  (6.3%), WATER, NIACINAMIDE, GLYCERIN, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, DIMETHICONE, BHT, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, TRIETHOXYCAPRYLYLSILANE, METHYLPARABEN, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, ALUMINUM STARCH OCTENYLSUCCINATE, DISODIUM EDTA, PROPYLPARABEN, HYDROXYETHYLCELLULOSE, HYDROLYZED HYALURONIC ACID, PHYTOSPHINGOSINE, XANTHAN GUM ',1,2,1,4,5);
-INSERT INTO PRODUCTS VALUES(5,'AM Facial Moisturizing Lotion SPF 50','HOMOSALATE (8%), ZINC OXIDE (7%), OCTISALATE (5%), OCTOCRYLENE (5%), WATER, GLYCERIN, DIMETHICONE, PROPANEDIOL, BUTYLOCTYL SALICYLATE, STEARETH-20, CELLULOSE, NIACINAMIDE, ETHYLHEXYL METHOXYCRYLENE, STEARETH-2, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, SORBITAN ISOSTEARATE, CARBOMER, GLYCINE SOJA (SOYBEAN) OIL, TRIETHOXYCAPRYLYLSILANE, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, TRIETHYL CITRATE, SODIUM HYALURONATE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, AMMONIUM POLYACRYLOYLDIMETHYL TAURATE, TOCOPHEROL, CHLORPHENESIN, HYDROXYACETOPHENONE, CAPRYLYL GLYCOL, HYDROXYETHYL ACRYLATE/SODIUM ACRYLOYLDIMETHYL TAURATE COPOLYMER, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, PHYTOSPHINGOSINE, XANTHAN GUM, POLYHYDROXYSTEARIC ACID, POLYSORBATE 60, ORYZA SATIVA (RICE) BRAN WAX, BENZOIC ACID, C12-22 ALKYL ACRYLATE/HYDROXYETHYLACRYLATE COPOLYMER',1,2,1,4,5);
-INSERT INTO PRODUCTS VALUES(6,'Oil Control Moisturizing Gel-Cream','AQUA / WATER / EAU, NIACINAMIDE, GLYCERIN, CETEARYL ISONONANOATE, C14-22 ALCOHOLS, ISOPROPYL MYRISTATE, ZEA MAYS STARCH / CORN STARCH, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, TRIETHYL CITRATE, SILICA, SODIUM HYDROXIDE, SODIUM HYALURONATE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, PHENOXYETHANOL, CITRIC ACID, CAPRYLYL GLYCOL, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, XANTHAN GUM, PHYTOSPHINGOSINE, POLYACRYLATE CROSSPOLYMER-6, BENZOIC ACID, C12-20 ALKYL GLUCOSIDE',1,4,1,4,5);
-INSERT INTO PRODUCTS VALUES(7,'Resurfacing Retinol Serum','AQUA/WATER/EAU, PROPANEDIOL, DIMETHICONE, CETEARYL ETHYLHEXANOATE, NIACINAMIDE, AMMONIUM POLYACRYLOYLDIMETHYL TAURATE, DIPOTASSIUM GLYCYRRHIZATE, HYDROGENATED LECITHIN, POTASSIUM PHOSPHATE, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, DIMETHICONOL, LECITHIN, SODIUM CITRATE, RETINOL, SODIUM HYALURONATE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, PHENOXYETHANOL, ALCOHOL, ISOPROPYL MYRISTATE, CAPRYLYL GLYCOL, CITRIC ACID, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, PENTYLENE GLYCOL, PHYTOSPHINGOSINE, XANTHAN GUM, POLYSORBATE 20, ETHYLHEXYLGLYCERIN',1,6,1,2,6);
-INSERT INTO PRODUCTS VALUES(8,'Pro Oil Control Foam wash','Aqua, Zinc Coceth Sulfate, Glycerin, PEG-75, Amyl Cinnamal, Benzyl Benzoate, Citronellol, Dipotassium Glycyrrhizate, Disodium EDTA, Geraniol, Hydroxycitronellal, Linalool, PEG-7 Glyceryl Cocoate, PEG-40 Hydrogenated Castor Oil, PEG-200 Hydrogenated Glyceryl Palmate, Sodium Benzoate, Zinc Gluconate, Perfume. ',1,1,2,1,9);
-INSERT INTO PRODUCTS VALUES(9,'Oily Skin Cleanser','Aqua, Glycerin, Cocamidopropyl Betaine, Disodium Laureth Sulfosuccinate, Sodium Cocoamphoacetate, Panthenol, Niacinamide, Pantolactone, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Benzoate, Parfum, Amyl Cinnamal, Citronellol, Geraniol, Hydroxycitronellal, Linalool, Sodium Chloride, Citric Acid.',1,4,2,1,9);
-INSERT INTO PRODUCTS VALUES(10,'Daily exfoliating cleanser','Aqua, Cocamidopropyl Betaine, Disodium Cocoamphodiacetate, Glycerin, Coco-Glucoside, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Bambusa Arundinacea Stem Extract, Citric Acid, Heliotropine, Panthenol, Parfum, Phenoxyethanol, Polyquaternium-10, Sodium Benzoate, Sodium Chloride, Sodium Citrate, Sodium Cocoamphoacetate, Sodium Hydroxide, Tocopheryl Acetate, Xanthan Gum.',1,6,2,1,9);
-INSERT INTO PRODUCTS VALUES(11,'Bright Healthy Radiance Reveal Creamy Cleanser','Glycerin, Aqua, Stearic Acid, Myristic Acid, Potassium Hydroxide, Lauric Acid, Palmitic Acid, Cocamidopropyl Betaine, Hydrogenated Polyisobutene, Glyceryl Stearate, Anhydroxylitol, Arachidic Acid, Butylene Glycol, Capric Acid, Caprylyl Glycol, Ethylhexylglycerin, Hydrogenated Polydecene, Niacinamide, Oleic Acid, Pancratium Maritimum Extract, Polyquaternium-7, Potassium Benzoate, Sodium Benzoate, Sorbitol, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',4,3,2,1,2);
-INSERT INTO PRODUCTS VALUES(12,'Gentle Skin Cleanser','Aqua, Glycerin, Cetearyl Alcohol, Panthenol, Niacinamide, Pantolactone, Xanthan Gum, Sodium Cocoyl Isethionate, Sodium Benzoate,  Citric Acid. ',4,2,2,1,9);
-INSERT INTO PRODUCTS VALUES(13,'Daily Exfoliating Cleanser','Aqua, Cocamidopropyl Betaine, Disodium Cocoamphodiacetate, Glycerin, Coco-Glucoside, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Bambusa Arundinacea Stem Extract, Citric Acid, Heliotropine, Panthenol, Parfum, Phenoxyethanol, Polyquaternium-10, Sodium Benzoate, Sodium Chloride, Sodium Citrate, Sodium Cocoamphoacetate, Sodium Hydroxide, Tocopheryl Acetate, Xanthan Gum.',4,6,2,1,9);
-INSERT INTO PRODUCTS VALUES(14,'Daily Advance Ultra Hydrating Lotion','Aqua, Glycerin, Isopropyl Palmitate, Helianthus Annuus Seed Oil, Cetearyl Alcohol, Ceteareth-20, Dimethicone, Butyrospermum Parkii Butter, Panthenol, Niacinamide, Tocopheryl Acetate, Sodium PCA, Pantolactone, Glyceryl Stearate, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Benzoate, Potassium Sorbate, Citric Acid.',4,2,2,4,9);
-INSERT INTO PRODUCTS VALUES(15,'Bright Healthy Radiance Brightness Refresh Toner','Aqua, Butylene Glycol, Niacinamide, Glycerin, 1,2-Hexanediol, Anhydroxylitol, Citric Acid, Ethylhexylglycerin, Hydrolyzed Cicer Seed Extract, Pancratium Maritimum Extract, Rhododendron Chrysanthemum Leaf Extract, Sodium Citrate, Tocopherol, Tricholoma Matsutake Extract, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',4,5,2,3,2);
-INSERT INTO PRODUCTS VALUES(16,'Bright Healthy Radiance Brightening Lotion','Aqua, Glycerin, Hydrogenated Polydecene, Niacinamide, Cetyl Ethylhexanoate, Caprylic/Capric Triglyceride, 1,2-Hexanediol, Cetearyl Alcohol, Butyrospermum Parkii Butter, Glyceryl Stearate, Butylene Glycol, Anhydroxylitol, Betaine, Citric Acid, Dimethicone, Ethylhexylglycerin, Hyaluronic Acid, Hydrolyzed Cicer Seed Extract, Hydrolyzed Hyaluronic Acid, Palmitic Acid, Pancratium Maritimum Extract, Polyglyceryl-2 Stearate ,Propanediol, Rhododendron Chrysanthemum Leaf Extract, Sodium Hyaluronate, Stearic Acid, Stearyl Alcohol, Tocopherol, Tricholoma Matsutake Extract, Xylitol, Xylitylglucoside',4,6,2,4,9);
-INSERT INTO PRODUCTS VALUES(17,'Daily Advance Ultra Hydrating Lotion','Aqua, Glycerin, Isopropyl Palmitate, Helianthus Annuus Seed Oil, Cetearyl Alcohol, Ceteareth-20, Dimethicone, Butyrospermum Parkii Butter, Panthenol, Niacinamide, Tocopheryl Acetate, Sodium PCA, Pantolactone, Glyceryl Stearate, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Benzoate, Potassium Sorbate, Citric Acid. FIL.1743.V00',3,5,2,4,9);
-INSERT INTO PRODUCTS VALUES(18,'Moisturising Cream','Aqua, Glycerin, Petrolatum, Dicaprylyl Ether, Dimethicone, Glyceryl Stearate, Cetyl Alcohol, Helianthus Annuus Seed Oil, Peg-30 Stearate, Panthenol, Niacinamide, Prunus Amygdalus Dulcis Oil, Tocopherol, Tocopheryl Acetate, Pantolactone, Dimethiconol, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Carbomer, Propylene Glycol, Bht, Disodium Edta, Benzyl Alcohol, Phenoxyethanol, Sodium Hydroxide, Citric Acid.',3,2,2,4,9);
-INSERT INTO PRODUCTS VALUES(19,'Gentle Skin Cleanser','Aqua, Glycerin, Cetearyl Alcohol, Panthenol, Niacinamide, Pantolactone, Xanthan Gum, Sodium Cocoyl Isethionate, Sodium Benzoate,  Citric Acid. ',3,2,2,1,9);
-INSERT INTO PRODUCTS VALUES(20,'Bright Healthy Radiance Reveal Creamy Cleanser','Glycerin, Aqua, Stearic Acid, Myristic Acid, Potassium Hydroxide, Lauric Acid, Palmitic Acid, Cocamidopropyl Betaine, Hydrogenated Polyisobutene, Glyceryl Stearate, Anhydroxylitol, Arachidic Acid, Butylene Glycol, Capric Acid, Caprylyl Glycol, Ethylhexylglycerin, Hydrogenated Polydecene, Niacinamide, Oleic Acid, Pancratium Maritimum Extract, Polyquaternium-7, Potassium Benzoate, Sodium Benzoate, Sorbitol, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',3,3,2,1,2);
-INSERT INTO PRODUCTS VALUES(21,'Bright Healthy Radiance Brightness Refresh Toner','Aqua, Butylene Glycol, Niacinamide, Glycerin, 1,2-Hexanediol, Anhydroxylitol, Citric Acid, Ethylhexylglycerin, Hydrolyzed Cicer Seed Extract, Pancratium Maritimum Extract, Rhododendron Chrysanthemum Leaf Extract, Sodium Citrate, Tocopherol, Tricholoma Matsutake Extract, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',3,5,2,3,2);
-INSERT INTO PRODUCTS VALUES(22,'Salicylic Acid + LHA 2% Cleanser','Aqua, Glycerin, Cocamidopropyl Betaine, Propanediol, Sodium Lauroyl Methyl Isethionate, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG-150 Pentaerythrityl Tetrastearate, PEG-120 Methyl Glucose Dioleate, Salicylic Acid, Betaine, Avena Sativa (Oat) Kernel Extract, Pentylene Glycol, Capryloyl Salicylic Acid, Panthenol, Allantoin, Zinc PCA, Sodium PCA, Coco-Glucoside, Glyceryl Oleate, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Lactic Acid, Citric Acid, Sodium Citrate, Sodium Hydroxide',1,1,3,1,1);
-INSERT INTO PRODUCTS VALUES(23,'Salicylic Acid 2% Face Serum','Aqua, Methylpropanediol, Butylene Glycol, Ethoxydiglycol, Dimethyl Isosorbide, Salicylic Acid, Glycerin, Marrubium Vulgare Extract, Pentylene Glycol, Polylysine, Sodium Hyaluronate, Epigallocatechin Gallatyl Glucoside, Hydroxyethylcellulose, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan, Phenoxyethanol, Lactic Acid, Isoceteth-20, Trisodium Ethylenediamine Disuccinate, Ethylhexylglycerin, Oligopeptide-10, Sodium Hydroxide',1,4,3,2,1);
-INSERT INTO PRODUCTS VALUES(24,'Niacinamide 10% Face Serum','Aqua, Niacinamide, Glycerin, Butylene Glycol, Dimethyl Isosorbide, Propanediol, Ethoxydiglycol, Acetyl Glucosamine, Pseudoalteromonas Ferment Extract, Zinc PCA, Zinc Glycinate, Allantoin, Sodium Hyaluronate, Hydroxyethylcellulose, Phenoxyethanol, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan, Ethylhexylglycerin',1,6,3,2,2);
-INSERT INTO PRODUCTS VALUES(25,'Vitamin B5 10% Moisturizer','Water/Aqua, Panthenol, Cyclopentasiloxane, Dimethicone Crosspolymer, Glycerin, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG/PPG-18/18 Dimethicone, Hydrogenated Polyisobutene, Magnesium Aspartate, Zinc Gluconate, Copper Gluconate, Sodium Hyaluronate, Betaine, Phenoxyethanol, Pentylene Glycol, Allantoin, Ethylhexylglycerin, Carbomer, Magnesium Sulfate Heptahydrate, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer',1,1,3,4,4);
-INSERT INTO PRODUCTS VALUES(26,'Alpha Arbutin 2% Face Serum','Aqua, Dimethyl Isosorbide, Ethoxydiglycol, Alpha Arbutin, Lactic Acid, Pentylene Glycol, Ferulic Acid, Sodium Hyaluronate, 4-n-Butylresorcinol, Hydroxyethylcellulose, Triethanolamine, PEG/PPG-17/6 Copolymer, Isoceteth-20, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate',2,6,3,2,4);
-INSERT INTO PRODUCTS VALUES(27,'Tranexamic 3% Face Serum','Aqua, Avena Sativa (Oat) Kernel Extract, Tranexamic Acid, Dimethyl Isosorbide, Mandelic Acid, Ethoxydiglycol, Pentylene Glycol, Methylpropanediol, Acetyl Glucosamine, Hydroxyphenoxy Propionic Acid, Sodium Hyaluronate, Salicylic Acid, Xanthan Gum, Lecithin, Phenoxyethanol, Sclerotium Gum, Pullulan, Ethylhexylglycerin, Curcuma Longa (Turmeric) Root Extract, Trisodium Ethylenediamine Disuccinate',2,6,3,2,9);
-INSERT INTO PRODUCTS VALUES(28,'SPF 50 Sunscreen','Aqua, Octocrylene, Diisopropyl Adipate, Propylene Glycol Dicaprylate/Dicaprate, C12-15 Alkyl Benzoate, Diisopropyl Sebacate, Butyl Methoxydibenzoylmethane, Isododecane, Niacinamide, Arachidyl Alcohol, Behenyl Alcohol, Arachidyl Glucoside, Caprylic/Capric Triglyceride, Titanium Dioxide, Ethylhexyl Triazone, PEG-100 Stearate, Glyceryl Stearate, Butylene Glycol, Linoleic Acid, Linolenic Acid, Panthenol, Sodium Hyaluronate, Allantoin, Tocopherol Acetate, Retinol, Polysorbate 20, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer, Xanthan Gum, Polyacrylate Crosspolymer-6, Silica, Acacia Gum, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate',2,6,3,5,2);
-INSERT INTO PRODUCTS VALUES(29,'Light Fluid SPF 50 Sunscreen','Water/Aqua, Ethylhexyl Methoxycinnamate, Diethylamino Hydroxybenzoyl Hexyl Benzoate, Dimethicone, Ethoxydiglycol, Methylene Bis-Benzotriazolyl Tetramethylbutylphenol, Propylene Glycol Dicaprylate/Dicaprate, Diisopropyl Sebacate, Diisopropyl Adipate, C12-15 Alkyl Benzoate, Butylene Glycol, Phenoxyethanol, VP/Eicosene Copolymer, Isododecane, Pentylene Glycol, Potassium Cetyl Phosphate, Acrylates/Polytrimethylsiloxymethacrylate Copolymer, Decyl Glucoside, Triethanolamine, Carbomer, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Polyacrylate Crosspolymer-6, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Propylene Glycol, Cyclopentasiloxane, Dimethicone Crosspolymer, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Polymethylsilsesquioxane, Xanthan Gum',2,6,3,5,9);
-INSERT INTO PRODUCTS VALUES(30,'Vitamin B5 10% Moisturizer','Water/Aqua, Panthenol, Cyclopentasiloxane, Dimethicone Crosspolymer, Glycerin, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG/PPG-18/18 Dimethicone, Hydrogenated Polyisobutene, Magnesium Aspartate, Zinc Gluconate, Copper Gluconate, Sodium Hyaluronate, Betaine, Phenoxyethanol, Pentylene Glycol, Allantoin, Ethylhexylglycerin, Carbomer, Magnesium Sulfate Heptahydrate, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer',3,1,3,4,4);
-INSERT INTO PRODUCTS VALUES(31,'Hyaluronic + PGA 2% Face Serum','Aqua, Sodium Hyaluronate Crosspolymer, Glyceryl Glucoside, Butylene Glycol, Glycerin, Sodium Polyglutamate, Methyl Gluceth-20, Dimethyl Isosorbide, Trehalose, Sodium Hyaluronate, Saccharomyces/Copper Ferment, Hydrolyzed Sodium Hyaluronate, Bacillus/Soybean Ferment Extract, Betaine, Panthenol, Pentylene Glycol, Biosaccharide Gum-1, Sodium Acetylated Hyaluronate, Phenoxyethanol, Chlorphenesin, Ethylhexylglycerin, Ethoxydiglycol',3,6,3,2,4);
-INSERT INTO PRODUCTS VALUES(32,'Aquaporin Booster 5% Cleanser','Aqua, Glycerin, Glyceryl Glucoside, Diglycerin, Methylpropanediol, Sodium Lauroyl Methyl Isethionate, Sodium Cocoamphoacetate, Disodium Cocoamphodiacetate, Pentylene Glycol, Methyl Gluceth-20, PEG-150 Pentaerythrityl Tetrastearate, Coco-Glucoside, Glyceryl Oleate, Panthenol, Betaine, Sodium PCA, PEG-120 Methyl Glucose Dioleate, Hydrolyzed Wheat Protein, Sodium Hyaluronate Crosspolymer, Avena Sativa (Oat) Kernel Extract, Allantoin, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Lactic Acid',3,6,3,1,4);
-INSERT INTO PRODUCTS VALUES(33,'Vitamin C 10% Face Serum','Centella Asiatica Leaf Water, 3-O-Ethyl Ascorbic Acid, Ethoxydiglycol, Dimethyl Isosorbide, Gluconolactone, Glycerin, Sodium Gluconate, Acetyl Glucosamine, Sodium Hyaluronate, Pullulan, Hydroxyethylcellulose, Xanthan Gum, Sclerotium Gum, Phenoxyethanol, Ethylhexylglycerin, Lecithin, Lactic Acid',4,6,3,2,5);
-INSERT INTO PRODUCTS VALUES(34,'Vitamin C + E + Ferulic 16% Face Serum','Aqua, 3-O-Ethyl Ascorbic Acid, Dimethyl Isosorbide, Butylene Glycol, Ethoxydiglycol, Sodium Citrate, Citric Acid, 1,2-Hexanediol, Sodium Gluconate, Ferulic Acid, Coceth-7, Phenoxyethanol, PPG-1-PEG-9 Lauryl Glycol Ether, Fullerenes, Tocopherol Acetate, Ethylhexylglycerin, PEG-40 Hydrogenated Castor Oil, PVP, Trisodium Ethylenediamine Disuccinate',4,6,3,2,5);
-INSERT INTO PRODUCTS VALUES(35,'Alpha Lipoic + Glycolic 7% Cleanser','Aqua, Glycerin, Cocamidopropyl Betaine, Propanediol, Glycolic Acid, Sodium Lauroyl Methyl Isethionate, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG-150 Pentaerythrityl Tetrastearate, PEG-120 Methyl Glucose Dioleate, Thioctic Acid, Betaine, Pentylene Glycol, Panthenol, Allantoin, Sodium PCA, Coco-Glucoside, Glyceryl Oleate, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Lactic Acid, Citric Acid, Sodium Citrate, Sodium Hydroxide',4,6,3,1,10);
-INSERT INTO PRODUCTS VALUES(36,'Green Tea Pore Cleansing Face Wash','Aqua (Water), Acrylates Copolymer, Sodium Laureth Sulphate (SLES), Glycerin, Cocamidopropyl Betaine, Cocamide DEA, Triethanolamine, Camellia Sinensis (Green Tea) Leaf Extract, Glycolic Acid, Cellulose Beads, Phenoxyethanol, Sodium Gluconate, Ethylhexylglycerin, Fragrance, CI 19140, CI 42090',1,1,4,1,10);
-INSERT INTO PRODUCTS VALUES(37,'1% Encapsulated Salicylic Acid Foaming Face Wash','Aqua, Sodium Methyl Oleoyl Taurate, Cocamidopropyl Betaine, Glycerin, Coco-Glucoside, Aloe Barbadensis Leaf Juice, Propanediol, Vaccinium Myrtillus (Blueberry) Fruit/Leaf Extract, Saccharum Officinarum (Sugar Cane) Extract, Citrus Aurantium Dulcis (Orange) Fruit Extract, Citrus Limon (Lemon) Fruit Extract, Acer Sachharum (Sugar Maple) Extract, Salicylic Acid, Dextrin, Polydextrose, Amylopectin, Niacinamide, Sodium Cocoyl Apple Amino Acids, Sodium Benzoate, Potassium Sorbate, Olive Oil PEG-7 Esters, Glycolic Acid, Sodium Gluconate, Sodium Benzotrizolyl Butylphenol, Sulfonate, Buteth-3, Tributyl Citrate, Fragrance',1,6,4,1,1);
-INSERT INTO PRODUCTS VALUES(38,'Green Tea Alcohol-Free Face Toner','Aqua, Glycerin, Peg-40 Hydrogenated Castor Oil, Camellia Sinensis (Green Tea) Leaf Extract, Sodium Gluconate, Phenoxyethanol, Glycolic Acid, Ethylhexylglycerin, Fragrance.',1,1,4,3,10);
-INSERT INTO PRODUCTS VALUES(39,'Green Tea Day-Light Sunscreen Gel SPF 35 PA+++','Aqua, 1,3-butylene glycol, aloe barbadensis (aloe) leaf juice, ammonium acryloyldimethyltaurate/vp copolymer, argania spinosa (argan) kernel oil, benzophenone-3, betain, butyl methoxydibenzoylmethane, calendula officinalis flower extract, camellia sinensis (green tea) leaf extract, ethylhexyl methoxycinnamate, ethylhexylglycerin, fragrance, glycerin, glycyrrhiza glabra (licorice) root extract, lycium barbarum (goji) fruit extract, niacinamide, phenoxyethanol, phospholipids, sodium gluconate',1,1,4,5,9);
-INSERT INTO PRODUCTS VALUES(40,'Green Tea Oil-Free Moisturizer','Aqua, Aloe Barbadensis Leaf Juice, Betaine, Isodecyl Neopentanoate, Niacinamide, Willow Bark Extract, Camellia Sinensis (Green Tea) Leaf Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Sodium Hyaluronate, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Glycerin, Sodium Polyacryloyldimethyl Taurate, Squalane, Cyclodextrin, Glyceryl Stearate, PEG-100 Stearate, Sorbitol, Sodium Gluconate',1,4,4,4,9);
-INSERT INTO PRODUCTS VALUES(41,'Salicylic & Lactic Acid Skin-Smoothing Gel Moisturizer','Aqua, Propylene Glycol, Niacinamide, Carbomer, Glycerin, Saccharum Officinarum (Sugar Cane) Extract, Vaccinium Myrtillus (Bilberry) Fruit Extract, Citrus Aurantium Dulcis (Orange) Fruit Extract, Acer Saccharinum (Sugar Maple) Extract, Citrus Medica Limonum (Lemon) Fruit Extract, Salicylic Acid, Lactic Acid, PEG-40 Hydrogenated Castor Oil, Opuntia Ficus (Prickly Pear) Extract, Tocopheryl Acetate, Phenoxyethanol, Ethylhexylglycerin, Disodium EDTA, Sodium Hydroxide',1,6,4,4,1);
-INSERT INTO PRODUCTS VALUES(42,'Green Tea Mattifying Moisturizer','Aqua (water), camellia sinensis (green tea) leaf extract, caprylic/capric triglyceride, cetearyl alcohol, ethylhexylglycerine, fragrance, fda approved colours, glycerin, glycolic acid, peg 400, phenoxyethanol, stearic acid, triethanolamine',1,1,4,4,9);
-INSERT INTO PRODUCTS VALUES(43,'3% Zinc Complex Face Serum with Green Tea','Aqua, Zinc Sebum (Zinc Chloride, Hydrolyzed Wheat Protein, Cinnamomum Zeylanicum (Cinnamon) Bark Extract, Thymus Vulgaris (Thyme) Extract, Malva Sylvestris (Mallow) Flower/Leaf/Stem Extract, Hamamelis Virginiana (Witch Hazel) Leaf Extract), Acnacidol (Butylene Glycol, 10-Hydroxydecanoic Acid, Sebacic Acid), Glycerin, Aloe Barbadensis Leaf Juice, Niacinamide, Ethoxydiglycol, Pentylene Glycol, Rosa Damascena (Rose) Flower Water, Zinc PCA, Camellia Sinensis (Green Tea) Leaf Extract, Vaccinium Myrtillus (Bilberry) Fruit Extract, Saccharum Officinarum (Sugarcane) Extract, Acer Saccharum (Sugar Maple) Extract, Hamamelis Virginiana (Witch Hazel) Water, Glycyrrhiza Glabra (Licorice) Root Extract, Citrus Aurantium Dulcis (Orange) Fruit Extract, Citrus Limon (Lemon) Fruit Extract, Cyclodextrin, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sorbitol, Xanthan Gum, Hydroxyethylcellulose, Sodium Gluconate, Sodium Citrate',1,6,4,2,9);
-INSERT INTO PRODUCTS VALUES(44,'2% Encapsulated Salicylic Acid Face Serum','Aqua, Glycerin, Salicylic Acid, Dextrin, Polydextrose, Amylopectin, Niacinamide, Propanediol, Pentylene Glycol, Vaccinium Myrtillus (Blueberry) Fruit Extract, Xanthan Gum, Hydroxyethylcellulose, Opuntia Ficus (Prickly Pear) Extract, Rosa Damascena (Rose) Flower Water, Sodium Copper Chlorophyllin, Ethoxydiglycol, Phenoxyethanol, Ethylhexylglycerin, Sodium Hydroxide, Disodium EDTA',1,6,4,2,1);
-INSERT INTO PRODUCTS VALUES(45,'10% Azelaic Acid & Cica Face Serum','Aqua, Azelaic Acid, Glycerin, Ethoxydiglycol, Centella Asiatica Extract, Niacinamide, Sodium Hyaluronate, Xanthan Gum, Allantoin, Tocopheryl Acetate, Phenoxyethanol, Sodium Metabisulfite',1,6,4,2,9);
-INSERT INTO PRODUCTS VALUES(46,'10% Niacinamide Face Serum with Rice Water','Aqua, Niacinamide (10%), Oryza Sativa (Rice) Extract, Isodecyl Neopentanoate, Squalane (5%), Sorbitol, Pentylene Glycol, Hydrogenated Polyisobutene, Rice Ferment Filtrate (Sake), Butylene Glycol, Rosa Damascena (Rose) Flower Water, Caffeine (1%), Glycyrrhiza Glabra (Licorice) Root Extract, Glycerin, Acrylates Copolymer, VP/Polycarbamyl Polyglycol Ester, Hydrolyzed Sesame Protein PG-Propyl Methylsilanediol, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Ethylhexyl Olivate, Sodium Acrylates Copolymer, Polyglyceryl-4 Olivate, Cyclodextrin, Sodium Hyaluronate, Tocopheryl Acetate, Sodium Gluconate',2,6,4,2,2);
-INSERT INTO PRODUCTS VALUES(47,'15% Vitamin C Face Serum with Mandarin','Aqua, Ethyl Ascorbic Acid, Propanediol, Betaine, Isodecyl Neopentanoate, Ethoxy Diglycol, Sodium Citrate, Citric Acid, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Rosa Damascena (Rose) Extract, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Trilaureth-4 Phosphate, Sodium Polyacryloyldimethyl Taurate, Xanthan Gum, Sodium Gluconate',2,6,4,2,5);
-INSERT INTO PRODUCTS VALUES(48,'2% Niacinamide & Rice Water Gel Cream','Aqua, Glycerin, Pseudoalteromonas Ferment Extract, Niacinamide, Oryza Sativa (Rice) Extract, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Triolein, Opuntia Ficus-Indica (Prickly Pear) Stem Water, Squalane, Ammonium Acryloyldimethyltaurate/VP Copolymer, Ethoxydiglycol, Cyclopentasiloxane, Phenoxyethanol, Ethylhexylglycerin, Disodium EDTA',2,6,4,4,2);
-INSERT INTO PRODUCTS VALUES(49,'3% Vitamin C Moisturizer with Mandarin','Aqua, Aloe Barbadensis Leaf Juice, Isodecyl Neopentanoate, 3-O-Ethyl Ascorbic Acid, Betaine, Glycerin, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Prunus Amygdalus Dulcis (Sweet Almond) Oil, Squalane, Sodium Citrate, Argania Spinosa (Argan) Kernel Oil, Ammonium Acryloyldimethyltaurate/VP Copolymer, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer, Cyclodextrin, Glyceryl Stearate, PEG-100 Stearate, Sucrose, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sodium Gluconate, Sodium Hyaluronate, Citric Acid, Dilauryl Thiodipropionate, Sodium Benzotriazolyl Butylphenol Sulfonate, Buteth-3, Tributyl Citrate',2,6,4,4,5);
-INSERT INTO PRODUCTS VALUES(50,'1.5% Vitamin C Face Toner with Mandarin','Aqua, Propanediol, 3-O-Ethyl Ascorbic Acid, Methyl Gluceth-20, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Hamamelis Virginiana (Witch Hazel) Extract, Sodium Citrate, Cyclodextrin, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sodium Gluconate, Sorbitol, Citric Acid, Sucrose',2,6,4,3,5);
-INSERT INTO PRODUCTS VALUES(51,'Vitamin C Foaming Face Wash with Mandarin','Aqua, Sodium Lauroyl Methyl Isethionate, Cocamidopropyl Betaine, Sodium Methyl Oleoyl Taurate, Lauryl Glucoside, Coco-Glucoside, Glycerin, Methyl Gluceth-20, 3-O-Ethyl Ascorbic Acid, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Phenoxyethanol, Ethylhexylglycerin, Sodium Gluconate, Fragrance, Citric Acid',2,6,4,1,5);
-INSERT INTO PRODUCTS VALUES(52,'2% Niacinamide & Rice Water Gel Cream','Aqua, Glycerin, Pseudoalteromonas Ferment Extract, Niacinamide, Oryza Sativa (Rice) Extract, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Triolein, Opuntia Ficus-Indica (Prickly Pear) Stem Water, Squalane, Ammonium Acryloyldimethyltaurate/VP Copolymer, Ethoxydiglycol, Cyclopentasiloxane, Phenoxyethanol, Ethylhexylglycerin, Disodium EDTA',3,6,4,4,2);
-INSERT INTO PRODUCTS VALUES(53,'Hello Aloe Caring Day Moisturizer | Aloe Vera Gel Moiturizer','Aqua, Aloe Barbadensis (Aloe) Leaf Juice*, Caprylic Capric Triglyceride, Propanediol, Glyceryl Mono-stearate, Glycerin, Glyceryl Stearate Citrate, Cetearyl Alcohol, Tocopheryl Acetate, Benzyl Alcohol Dehydro Acetic Acid, Potassium Sorbate, Fragrance, Xanthan Gum, Embelica Officinalis (Amla) Extract* * - ingredients from organic farming',3,6,4,4,9);
-INSERT INTO PRODUCTS VALUES(54,'Hemp & Ceramides Moisturizer With Algae Oil & Aloe Extracts','Aqua, Aloe Barbadensis Leaf Juice, Propanediol, Undecane, Tridecane, Ceramide NP, Ceramide AP, Ceramide EOP, Phytosphingosine, Cholesterol, Sodium Lauroyl Lactylate; Carbomer; Xanthan Gum, Glycerin, Cannabis Sativa (Hemp) Seed Oil, Triolein, Hydrolysed Sodium Hyaluronate, Butyrospermum Parkii (Shea) Butter, Sucrose, Cyclodextrin, Glyceryl Stearate, PEG-100 Stearate, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sorbitol, Cetearyl Alcohol, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Aminomethyl Propanol, Fragrance, Sodium Gluconate, Carbomer, Dilauryl Thiodipropionate, Tocopheryl Acetate',3,6,4,4,6);
-INSERT INTO PRODUCTS VALUES(55,'1% Resveratrol & Vitamin C Youthful Glow Moisturizer','Aqua, Isopropyl Myristate, Caprylic/Capric Triglyceride, Glycerin, Glyceryl Stearate, PEG-100 Stearate, Helianthus Annuus (Sunflower) Seed Oil, Glyceryl Monostearate, Hydroxypropyl Cyclodextrin, Cyclodextrin, Polydextrose, Resveratrol, 3-O-Ethyl Ascorbic Acid, Theobroma Cacao (Cocoa) Seed Butter, Butyrospermum Parkii (Shea) Butter, Cetearyl Alcohol, Phenoxyethanol, Ethylhexylglycerin, Oryza Sativa (Rice) Bran Wax, Stearic Acid, Ammonium Acryloyldimethyltaurate/VP Copolymer, Sodium Citrate, Fragrance, Sodium Polyacryloyldimethyl Taurate, Sodium Gluconate, CI 15510, CI 45350, Citric Acid',3,6,4,4,9);
-INSERT INTO PRODUCTS VALUES(56,'1% Oat & Allantoin Nourishing Face Cream with Vitamin E','Aqua, Glycerin, Helianthus Annuus (Sunflower) Seed Oil, Caprylic/Capric Triglyceride, Undecane, Tridecane, Cetearyl Alcohol, Cetearyl Olivate, Sorbitan Olivate, Avena Sativa (Oat) Kernel Extract, Allantoin, Zea Mays (Corn) Starch, Argania Spinosa (Argan) Kernel Oil, Ammonium Polyacryloyldimethyl Taurate, Glyceryl Monostearate, Polyacrylate Crosspolymer-6, Panthenol, Butyrospermum Parkii (Shea) Butter, Silica, Phenoxyethanol, Ethylhexylglycerin, Pentaerythrityl Tetra-di-t-butyl Hydroxyhydrocinnamate, Oryza Sativa (Rice) Bran Wax, Fragrance, Titanium Dioxide, Sodium Gluconate, Tocopheryl Acetate, Sodium Hydroxide, Citric Acid',3,6,4,4,9);
-INSERT INTO PRODUCTS VALUES(57,'Salicylic Acid Oil Control Face Wash For Oily Skin - 0.5% Salicylic acid and 1% Niacinamide','Aqua, Cocamidopropyl betaine, Sodium Methyl 2-Sulfolaurate, Disodium 2-Sulfolaurate, Sodium Methyl Cocoyl Taurate, Chamomilla Recutita extract, Aloe Barbadensis extract, Glycerine, Xylitylglucoside , Anhydroxylitol, Xylitol, Niacinamide, Xanthan Gum, Ethylhexylglycerin, Phenoxyethanol, Salicylic Acid, Betaine, Sodium Gluconate.',1,1,5,1,1);
-INSERT INTO PRODUCTS VALUES(58,'Oil-Free Moisturizer for Oily Skin - 3% NMF Complex + 0.2% Panthenol | Non-Comedogenic Moisturizer','Aqua, Calendula Officinalis (Flower) Extract, Glycerin, Diheptyl Succinate, Aloe barbadensis (Aloe Vera) Extract, Triethanolamine, Phenoxyethanol, Betaine, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Pyrrolidone Carboxylate, Sodium PCA, Panthenol, Sodium Gluconate, Ethylhexylglycerin, Sodium lactate, PCA, Capryloyl Glycerin/Sebacic Acid Copolymer, Serine, Alanine, Glycine, Glutamic Acid, Lysine HCl, Threonine, Arginine, Proline',1,1,5,4,9);
-INSERT INTO PRODUCTS VALUES(59,'Breakout Control D.S. Serum - 5% Succinic Acid + 8% PAD','Water (Aqua), Potassium Azeloyl Diglycinate (PAD), Succinic Acid, Citrus Paradisi Fruit extract, Vitis Vinifera Seed Extract, Glycerine, Propylene Glycol, Xylitylglucoside, Anhydroxylitol, Betaine, Sodium Cocyl Amino acids, Sarcosine, Xylitol, Sodium Gluconate, Magnesium Aspartate, Potassium Aspartate, Phenoxyethanol, Triethanolamine, Xanthan Gum, Ethylhexylglycerin, Glucose, Sodium Benzoate, Imidazole urea, Imidazolidinyl urea',1,1,5,2,9);
-INSERT INTO PRODUCTS VALUES(60,'Breakout Control Serum - 3.3% Potassium Azeloyl Diglycinate','Aqua, Potassium Azeloyl Diglycinate, Camellia Sinensis (Green Tea) Leaf extract, Glycerin, Chamomille Recutita (matricaria) flower extract, Xylitylglucoside, Anhydroxylitol, xylitol, Azardica Indica extract, Phenoxyethanol,  Ethylhexylglycerin, Guar gum, Xanthan gum, Betaine, Sophorolipid, Sodium Gluconate, Lactococcus Ferment Lysate, Triethanolamine',1,6,5,2,9);
-INSERT INTO PRODUCTS VALUES(61,'2% Salicylic Acid + 3% Niacinamide - Pore Control Serum','Aqua, Propylene Glycol, Niacinamide, Camellia Sinensis (Green Tea) Leaf Extract, Salicylic Acid, Xylitylglucoside, Anhydroxylitol, Xylitol, Piper nigrum (Black pepper) Seed  extract, Triethanolamine, Phenoxyethanol, Ethylhexylglycerin, Xanthan gum, Phyllanthus Emblica (Amla) Fruit Extract, Capryloyl Glycine, Sarcosine, Cinnamomum Zeylanicum bark Extract, Glycerine, Palmaria Palmata extract, EDTA, Sophorolipid',1,1,5,2,2);
-INSERT INTO PRODUCTS VALUES(62,'Clearing Niacinamide Serum - 5 % Niacinamide + 2 % Alpha Arbutin','Water, Niacinamide, Alpha Arbutin, Dictyopteris Membranacea, Glycerin, Phenoxyethanol, Ethylhexylglycerin, Sodium PCA , Trehalose, Xanthan Gum, Sodium Gluconate',4,6,5,2,2);
-INSERT INTO PRODUCTS VALUES(63,'Brightening Niacinamide Serum - 10% Niacinamide + 0.3% Alpha Arbutin','Aqua, Niacinamide, Glycerin, Xylitylglucoside (and) anhydroxylitol (and) xylitol, Phenoxyethanol (and) Ethylhexylglycerin, Xanthan gum, Guar gum, Alpha Arbutin, EDTA, Brassica Campestris (Rapeseed) Seed Oil, Glycyrrhiza Glabra (Licorice)Root Extract, Polyglyceryl-3 Diisostearate, Althaea Officinalis Root Extract, Oryza Sativa (Rice) Bran Extract, Palmaria Palmata extract, Bellis Perennis (Daisy) Flower Extract',4,6,5,2,2);
-INSERT INTO PRODUCTS VALUES(64,'Brightening Moisturizer- 5% Niacinamide + 1% Kojic Acid Dipalmitate','Aqua, Hydrogenated Olive Oil Unsaponifiables (and) Hydrogenated Ethylhexyl Olivate (and) Dibutyl Adipate, Niacinamide, 3-O-Ethyl Ascorbic Acid (and) Water, Polyacrylate-13 (and) Polyisobutene (and) Polysorbate 20, Glycerin, Diheptyl Succinate (and) Capryloyl Glycerin/Sebacic Acid Copolymer, Kojic acid dipalmitate, Phenoxyethanol, Ethylhexylglycerin, C6- C12 Triglycerides, Sodium Acrylate/Sodium Acryloyldimethyl Taurate Copolymer (and) Isohexadecane (and) Polysorbate 80, Niacinamide (and) Citrus Junos Seed Extract (and) Lactobacillus Ferment (and) Scutellaria Baicalensis Root Extract, Lactic Acid, Vitamin E, Sodium Gluconate',4,6,5,4,2);
-INSERT INTO PRODUCTS VALUES(65,'Niacinamide Brightening Face Wash - 2% Niacinamide + 2% Liquorice root extract','Aqua, Cocoamidopropyl betaine, Sodium Methyl Cocoyl Taurate, Glycerine, Xylitylglucoside, Anhydroxylitol, Xylitol, Sodium Methyl 2-Sulfolaurate,  Disodium 2-Sulfolaurate, Guar Gum, Niacinamide, Glycyrrhiza Glabra (Licorice) Root Extract, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Ethylhexylglycerin, Phenoxyethanol , Triethanolamine, Sodium Gluconate.',4,6,5,1,2);
-INSERT INTO PRODUCTS VALUES(66,'2% Hyaluronic Acid Serum with 1% Niacinamide | Oil Free Hydrating Face serum','Aqua, Xylitylglucoside, Anhydroxylitol, Xylitol, Hyaluronic Acid, Piper nigrum (black pepper) Seed extract, Butylene Glycol, Niacinamide, Xanthan Gum, Phenoxyethanol, Ethylhexylglycerin, Magnesium Aspartate (and) Zinc Gluconate (and) Copper Gluconate, Sodium Gluconate',3,6,5,4,2);
-INSERT INTO PRODUCTS VALUES(67,'Hyaluronic Acid Hydrating Face Wash - 0.5% Amino Acids + 0.1% Hyaluronic acid','Aqua, Cocamidopropyl betaine, Sodium Methyl Cocoyl Taurate, Sodium Methyl 2-Sulfolaurate, Disodium 2-Sulfolaurate, Glycerine, Xylitylglucoside, Anhydroxylitol, Xylitol, Maltooligosyl Glucoside, Hydrogenated Starch Hydrolysate, Sodium PCA, Panthenol, Sodium Hyaluronate, Proline, Hydroxyproline, Caprylic Capric Triglyceride, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Triethanolamine, Ethylhexylglycerin, Phenoxyethanol, Silica, Betaine, Sodium Gluconate, Hyaluronic Acid',3,6,5,4,1);
-INSERT INTO PRODUCTS VALUES(68,'1% Salicylic Acid Gel Face Wash with Salicylic Acid & Witch Hazel ','Aqua, Sodium Alpha Olefin Sulfonate, Cocamidopropyl Betaine, Glycerin, Cocamide MEA, Sodium Lactate, Salicylic Acid, Salix Alba (Willow) Bark Extract, Hamamelis Virginiana (Witch Hazel) Extract, Coco-Glucoside,Glyceryl Oleate, PEG-120 Methyl Glucose Dioleate, Sodium Chloride, Phenoxyethanol, Triethylene glycol & Disodium EDTA.',1,1,6,1,1);
-INSERT INTO PRODUCTS VALUES(69,'2% Salicylic Acid Gel Face Wash with Salicylic Acid & Witch Hazel','Aqua, Sodium Lauroyl Sarcosinate, Acrylates Copolymer, Disodium Cocoamphodiacetate, Cocamidopropyl Betaine, Glycerin, Caprylyl/Capryl Glucoside, Sodium Methyl Cocoyl Taurate, Salicylic Acid, Glyceryl Glucoside, Sodium Hydroxide, Phenoxyethanol, Ethylhexylglycerin, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera Extract, Betaine, Hamamelis Virginiana Extract, PEG-8, Isostearamidopropyl Ethyldimonium Ethosulfate, Propylene Glycol, Olea Europaea Fruit Oil, Carthamus Tinctorius Seed Oil, Panthenol, Xylitylglucoside, Benzophenone-4, Saccharide Isomerate, Citric Acid, Sodium Citrate, Salix Alba Extract, Sodium Benzoate, Allantoin and Sodium Gluconate.',1,1,6,1,1);
-INSERT INTO PRODUCTS VALUES(70,'3% AHA+BHA Foaming Daily Face Wash','Aqua, C14-16 Olefin Sulfonate, Mandelic Acid, Maltooligosyl Glucoside (and) Hydrogenated Starch Hydrolysate, Decyl Glucoside, Sodium Lauroyl Sarcosinate, Glycerin, Propylene Glycol, Sodium Hydroxide, Salicylic Acid, Glycolic Acid, Polysorbate 20, Coco-Glucoside (and) Glyceryl Oleate, Cocamidopropyl Hydroxysultaine, PEG-120 Methyl Glucose Dioleate, Allantoin, DMDM- Hydantoin (and) Methylchloroisothiazolinone (and) Methylisothiazolinone.',1,6,6,1,1);
-INSERT INTO PRODUCTS VALUES(71,'1% Salicylic Acid Foaming Daily Face Wash with Salicylic Acid, Zinc PCA & PHA','Aqua, Cocamidopropyl Betaine, Cocamidopropyl Hydroxysultaine, Maltooligosyl Glucoside and Hydrogenated Starch Hydrolysate, Decyl Glucoside, Disodium Laureth Sulfosuccinate, Sodium Lauroyl Sarcosinate, Propylene Glycol, Glycerin, Salicylic Acid, Zinc PCA, Allantoin, Sodium Cocoyl Isethionate, Coco-Glucoside & Glyceryl Oleate, Glucono Delta Lactone, Capryloyl Glycine & Sarcosine & Cinnamomum Zeylanicum Bark Extract, DMDM Hydantoin & Methylchloroisothiazolinone & Methylisothiazolinone, Sodium Hydroxide.',1,1,6,1,1);
-INSERT INTO PRODUCTS VALUES(72,'2% Salicylic Acid Serum','Aqua, Salix Alba (Willow) Bark Extract, Salicylic Acid, Ethoxydiglycol, Glycerin, Hamamelis Virginiana (Witch Hazel) Extract, Citric Acid, Phenoxyethanol, Ethylhexylglycerin, Hydroxyethylcellulose, Sodium Benzoate, Potassium Sorbate, Sodium Metabisulfite, Sodium Hydroxide & Disodium EDTA.',1,1,6,2,1);
-INSERT INTO PRODUCTS VALUES(73,'Sali-Cinamide Anti-Acne Serum with 2% Salicylic Acid & 5% Niacinamide ','Purified Water, Propanediol, Niacinamide, Ethoxydiglycol, Butylene Glycol & Rosa Canina Fruit Extract, PEG-8, Salicylic Acid, Isostearamidopropyl Ethyldimonium Ethosulfate, Sodium Hydroxide, Propylene Glycol, Olea Europaea (Olive) Fruit Oil, Carthamus Tinctorius (Safflower) Seed Oil, Salicylic Acid, Glycerine, Isoamyl Laurate, Alpha Arbutin, Willow Bark Extract, Centella Asiatica Extract, Allantoin, Hydroxyethyl Cellulose, Sodium Hyaluronate, Xylitylglucoside (and) Anhydroxylitol (and) Xylitol, Triethanolamine, Phenoxyethanol (and) Ethylhexylglycerin.',1,6,6,2,1);
-INSERT INTO PRODUCTS VALUES(74,'1% Salicylic Acid Oil-Free Moisturizer For Face with Oat Extract','Aqua, Caprylic/Capric Triglyceride, Cyclopentasiloxane, Sodium Polyacrylate, Dimethicone, Trideceth-6, PEG/PPG-18/18 Dimethicone, Glycerin, Salicylic Acid, Cetearyl Alcohol, Dicetyl Phosphate, Ceteth-10 Phosphate, Saccharide Isomerate, Avena Sativa (Oat) Bran Extract, Carbomer, Cetyl Alcohol, Stearic Acid, Phenoxyethanol, Ethylhexylglycerin, Sodium Hyaluronate, Citric Acid, Sodium Citrate, Sodium Hydroxide, Butylated Hydroxy Toluene & Disodium EDTA.',1,6,6,4,1);
-INSERT INTO PRODUCTS VALUES(75,'1% Kojic Acid Face Wash with Niacinamide & Alpha Arbutin','Myristic Acid, Glycerin, Aqua, Potassium Hydroxide, Propylene Glycol, Stearic Acid, Decyl Glucoside, Lauric Acid, Glycol Distearate, Cocamidopropyl Betaine, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera (Date Palm) Extract, Kojic Acid Dipalmitate, Niacinamide, Sodium PCA, Glyceryl Stearate, Phenoxyethanol, Polyquaternium-7, Titanium Dioxide, Sodium Metabisulfite, Butylated Hydroxytoluene, Vitamin E, Pentaerythrityl Tetra-Di-t-Butyl Hydroxyhydrocinnamate, Disodium EDTA, Glyceryl Glucoside and Alpha Arbutin.',2,6,6,1,3);
-INSERT INTO PRODUCTS VALUES(76,'2% Cica-Glow Daily Face Wash with Tranexamic Acid & Licorice Extract','Aqua, Sodium Lauroyl Sarcosinate, Acrylates Copolymer, Cocamidopropyl Betaine, Aloe Barbadensis Leaf Juice, Centella Asiatica Extract, Decyl Glucoside, Sodium Cocoyl Apple Amino Acids, Xylitylglucoside, Anhydroxylitol, Xylitol, Glycolic Acid, Tranexamic Acid, Glycyrrhiza Glabra Root Extract, Tocopheryl Acetate, Gillet-C, Methylchloroisothiazolinone, Methylisothiazolinone & Sodium Hydroxide.',2,6,6,1,9);
-INSERT INTO PRODUCTS VALUES(77,'Tran-Zelaic Pigmentation Corrector Face Wash with Tranexamic Acid & Azelaic Acid','Aqua, Acrylates Copolymer, Sodium Lauroyl Sarcosinate, Propanediol, Cocamidopropyl Betaine, Sodium Cocoamphoacetate, Niacinamide, Polyglycerin-3, Sodium Methyl Oleoyl Taurate, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera Extract, Tranexamic Acid, Azelaic Acid, Sodium Cocoyl Apple Amino Acids, Sodium Hydroxide, Saccharide Isomerate, Citric Acid, Sodium Citrate, Phenoxyethanol, Ethylhexylglycerin, Glycolipids, Panthenol, Sodium PCA, Betaine, Benzophenone-4, Sodium Gluconate, Soyethyl Morpholinium Ethosulfate, Lactic Acid and Glycolic Acid.',2,4,6,1,9);
-INSERT INTO PRODUCTS VALUES(78,'Nia-Ceramide Barrier Repair Face Wash with 2% Niacinamide and 1% Ceramide','Aqua, Sodium Lauroyl Sarcosinate, Acrylates Copolymer, Cocamidopropyl Betaine, Disodium Cocoamphodiacetate, Polyglycerin 3, Propanediol, Niacinamide, Sodium Cocoyl Apple Amino Acids, Glycerin, Glyceryl Glucoside, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera Extract, Laminaria Digitata Extract, Lecithin Hydrogenated, Cetyl-Pg Hydroxyethyl Palmitamide, Ceramide EOP, Ceramide NG, Ceramide NP, Ceramide AS, Ceramide AP, Cholesterol, 1,2-Hexanediol, Sodium Hydroxide, Phenoxyethanol, Ethylhexylglycerin, Glycolipids, Saccharide Isomerate, Citric Acid, Sodium Citrate, Panthenol, Sodium PCA, Natural Betaine, Benzophenone- 4 and Soyethyl Morpholinium Ethosulfate.',2,4,6,1,2);
-INSERT INTO PRODUCTS VALUES(79,'3% Niacinamide Foaming Face Wash','Aqua, Ethylhexyl Methoxycinnamate, Niacinamide, Cyclopentasiloxane (and) Dimethicone Crosspolymer, Methylene Bis-Benzotriazolyl, Tetramethylbutylphenol, Aqua, Cocamidopropyl Betaine, Cocamidopropyl Hydroxysultaine, Maltooligosyl Glucoside and Hydrogenated Starch Hydrolysate, Decyl Glucoside, Disodium Laureth Sulfosuccinate, Sodium Lauroyl Sarcosinate, Propylene Glycol, Glycerin, Niacinamide, Gluconolactone, Glycolic Acid, Allantoin, Sodium Cocoyl Isethionate, Polysorbate 20, Coco-glucoside & Glyceryl Oleate, Peg-120 Methyl Glucose Dioleate, DMDM Hydantoin & Methylchloroisothiazolinone & Methylisothiazolinone, Sodium Hydroxide',2,6,6,1,2);
-INSERT INTO PRODUCTS VALUES(80,'7% Glycolic Acid Hydrating Toner with Glycolic Acid & Hyaluronic Acid','Purified Water, Glycolic Acid, Triethanolamine, Glycerine, Sodium Hyaluronate, Hordeum vulgare Seed Extract, Aloe vera Juice, Phenoxyethanol, Ethylhexylglycerine, Allantoin, Hydroxyethylcellulose, Laminaria Digitata Extract & Cetyl-PG Hydroxyethyl Palmitamide & Ceramide 1 & Ceramide 2 & Ceramide 3 & Ceramide 4 & Ceramide 6 II, Olea Europaea (Olive) Leaf Extract, Xylitylglucoside, Anhydroxylitol and Xylitol.',2,4,6,3,10);
-INSERT INTO PRODUCTS VALUES(81,'2% Alpha Arbutin Face Serum','Aqua, Arbutin, Propanediol, Tartaric Acid, Disodium EDTA, Sodium Sulfite, Sodium Metabisulfite, Glycerin, Butylene Glycol, Saxifraga Sarmentosa Extract, Carica Papaya Fruit Extract, Psidium Guajava Fruit Extract, Ethoxydiglycol, Sodium Hyaluronate, PEG-40 Hydrogenated Castor Oil, Maltodextrin, Punica Granatum Seed Cell Culture Lysate, Polyacrylate Crosspolymer-6, Phenoxyethanol, Chlorphenesin, Glycerin,Disodium EDTA',2,6,6,2,4);
-INSERT INTO PRODUCTS VALUES(82,'10% Cica-Glow Face Serum with Tranexamic Acid & Kojic Acid','DM Water, Cica Extract, Niacinamide, Tranexamic Acid, Alpha Arbutin, 1,3 - Propanediol, 3-O-Ethyl Ascorbic acid, Gluconolactone, Diethylene Glycol Monoethyl Ether, Phenoxyethanol, Kojic Acid, Citric Acid, Hydroxy Ethyl Cellulose, Ethylhexylglycerin, Sodium Metabisulphite, Xanthan Gum, Sodium Gluconate, Sclerotium Gum, Lecithin, Pullulan, Ferulic Acid, Licorice Extract, Goji Berry Extract & Silica',2,4,6,2,3);
-INSERT INTO PRODUCTS VALUES(83,'5% Vitamin C Daily Face Serum with Ferulic Acid & Multivitamin','Water, 1,3-Propanediol, 3-0-Ethyl Ascorbic Acid, Diethylene Glycol Monoethyl Ether, Sodium Hyaluronate, Phenoxyethanol, Hydrogenated Castor Oil, D-Panthenol, Ferulic Acid, Hydroxyethylcellulose, Ethylhexylglycerin, Dimethyl Isosorbide, Vitamin E Acetate, Sodium Gluconate, Glycerin, Ascorbyl Palmitate, Potassium Sorbate, Niacinamide, Pyridoxine HCL, Inositol, Biotin, Thiamine HCL & Riboflavin',2,6,6,2,5);
-INSERT INTO PRODUCTS VALUES(84,'2% Glutathione Face Serum With Glutathione and Tranexamic Acid','Aqua, Ethoxydiglycol, Tranexamic Acid, Glutathione, Methyl Gluceth-20, Tartaric Acid, Glycerin, Butylene Glycol, Saxifraga Sarmentosa Extract, Carica Papaya (Papaya) Fruit Extract, Psidium Guajava Fruit Extract, Propanediol, PEG-40 Hydrogenated Castor Oil, Phenoxyethanol, Triethylene glycol, Hydroxyethyl Cellulose, Curcuma Longa Rhizomes Extract, Tetrahydrocurcumin, Disodium EDTA, Sodium Sulfite & Sodium Metabisulfite',2,6,6,2,9);
-INSERT INTO PRODUCTS VALUES(85,'C-Cinamide Radiance Serum With 10% Vitamin C & 5% Niacinamide','Purified Water, Propanediol, 3-O-Ethyl Ascorbic Acid, Niacinamide, Diethylene Glycol Monoethyl Ether, Sodium Hyaluronate, Bis-PEG-18 Methyl Ether Dimethyl Silane, Phenoxyethanol Ethylhexylglycerin, Ferulic Acid, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan & Sodium Gluconate',2,6,6,2,5);
-INSERT INTO PRODUCTS VALUES(86,'Ceramide + HA Intense Daily Face Moisturizer','Purified Water, Glycerin, Sodium Acrylate/Sodium Acryloyldimethyl Taurate - Copolymer (and) Hydrogenated Polydecene (and) Trideceth-6 (and) Sorbitan Laurate, Cetearyl Alcohol, Caprylic/Capric Triglyceride, Saccharide Isomerate (and) Aqua (and) Citric Acid (and) Sodium Citrate, Behenyl Alcohol, Sorbitan Stearate (and) Sucrose Cocoate, Cetearyl Alcohol (and) Dicetyl Phosphate (and) Ceteth-10 Phosphate, Cyclopentasiloxane (and) Phenyl Trimethicone (and) Dimethiconol (and) C12-15 Alkyl Benzoate (and) Dimethicone Crosspolymer, Phenoxyethanol (and) Ethylhexylglycerin, Xylitylglucoside (and) Anhydroxylitol (and) Xylitol, Avena Sativa (Oat) Kernel Flour, Sodium Hyaluronate (and) Aqua (and) Phenoxyethanol, Panthenol, Ceramide 3, Ceramide 6 II, Ceramide 1, Phytosphingosine, Cholesterol, Sodium Lauroyl Lactylate, Carbomer, Xanthan Gum, Disodium EDTA & Pentaerythrityl Tetra-Di-T-Butyl Hydroxyhydrocinnamate.',2,4,6,4,6);
-INSERT INTO PRODUCTS VALUES(87,'5% Vitamin C Oil-Free Daily Face Moisturizer for Skin Radiance','Aqua, 3-O-Ethyl Ascorbic Acid, Glycerin, Isodecyl Neopentanoate, Cetearyl Olivate (and) Sorbitan Olivate, Propanediol, Dicaprylyl Carbonate, C15-19 Alkane, Cetyl Alcohol, Polyacrylate Crosspolymer-11, Xylitol, Phenoxyethanol (and) Ethylhexylglycerin, Saccharide Isomerate (and) Aqua (and) Citric Acid (and) Sodium Citrate, Betaine and Disodium Ethylenediaminetetraacetic acid.',2,4,6,4,5);
-INSERT INTO PRODUCTS VALUES(88,'1% Hyaluronic Long Lasting Sunscreen SPF 50 & PA++++ with Hyaluronic Acid & Vitamin E','Aqua, Cyclopentasiloxane, Ethylhexyl Methoxycinnamate, Titanium Dioxide, Diethylhexyl Butamido Triazone, Cetyl PEG/PPG-10/1 Dimethicone, Aluminum Chlorohydrate, Dimethicone/Vinyl Dimethicone Crosspolymer, Zinc Oxide, Coco-Caprylate/Caprate, Polyglyceryl-3 Polyricinoleate, Isostearic Acid, Isododecane, Disteardimonium Hectorite, Propylene Carbonate, Bis-Ethylhexyloxyphenol Methoxyphenyl Triazine, Hyaluronic Acid, Physalis Angulata Extract, Caprylic/Capric Triglyceride, Vitamin E, Fructooligosaccharides, Beta Vulgaris Root Extract, Glycerin, Butylene Glycol, Sodium Benzoate, Phenoxyethanol',2,6,6,5,4);
-INSERT INTO PRODUCTS VALUES(89,'Ultra Light Zinc Mineral Sunscreen with SPF 50','Purified Water, Zinc Oxide, C12-15 Alkyl Benzoate, Isostearic Acid, Polyhydroxystearic Acid, Cyclopentasiloxane, PEG/PPG-18/18 Dimethicone, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Silica, Glycerin, Dimethicone/Vinyl Dimethicone Crosspolymer, Cetyl PEG/PPG-10/1 Dimethicone, Sodium Chloride, Polymethylsilsesquioxane, Caprylyl Glycol, Methylpropanediol, Didecyldimonium Chloride, Polyquaternium-80, Xanthan Gum and Magnesium Aluminium Silicate.',2,6,6,5,9);
-INSERT INTO PRODUCTS VALUES(90,'2% Vitamin C Gel Daily Face Wash with Vitamin C, Rosehip & Orange Peel Extract','Purified Water, Cocamidopropyl Betaine, Sodium Laureth-5 Carboxylate, Decyl Glucoside, Acrylates Copolymer, Coco Glucoside, Cocamide Monoethanolamine, Glycerin, Propanediol, Sodium Ascorbyl Phosphate, Polysorbate 20, Rosehip Extract, Orange Peel Extract, Citric Acid, Sodium Hydroxide, Methylchloroisothiazolinone & Methylisothiazolinone and Ethylenediaminetetraacetic acid.',3,3,6,1,1);
-INSERT INTO PRODUCTS VALUES(91,'2% Niacinamide Gentle Skin Cleanser','Aqua, Cetostearyl Alcohol, Glycerin, Niacinamide, Sodium Cocoyl Isethionate, Phenoxyethanol Ethylhexylglycerin, Cocamidopropyl Betaine, Xanthan Gum, Cica Extract, Cetyl Alcohol, Carbomer, Saccharide Isomerate, Sodium Gluconate, Ceramide Complex, Oatmeal Extract, Vitamin E, Citric Acid and Sodium Citrate.',3,2,6,1,1);
-INSERT INTO PRODUCTS VALUES(92,'Creamy Cleanser ','Purified Water, Cetyl Alcohol, Propylene Glycol, Phenoxyethanol, Sodium Lauryl Sulphate, Stearyl Alcohol, Polysorbate-80',3,5,6,1,1);
-INSERT INTO PRODUCTS VALUES(93,'4% Ceramide Barrier Repair Moisturizer','Aqua, Ceramide Complex, Caprylic/Capric Triglyceride, Niacinamide, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera extract, Sodium Acrylates Copolymer, Lecithin, Propylene Glycol, Polyglycerin-3, Glyceryl Stearate, Xylitylglucoside, Dimethicone, Phenoxyethanol, Ethylhexylglycerin, Shea Butter Glycerides, Cetearyl Olivate, Sorbitan Olivate, Sodium Benzoate, Stearic Acid, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Disodium EDTA, Sodium Hydroxide, Hyaluronic Acid, Glycerin, Ligustrum Lucidum Seed Extract, Fucus Vesiculosus Extract, Butylene Glycol, 1,2-Hexanediol and Tocopheryl Acetate',3,6,6,4,6);
-INSERT INTO PRODUCTS VALUES(94,'5% Cica-Glow Daily Face Moisturizer','Aqua, Centella Asiatica Extract, Cyclopentasiloxane, Glycerin, Caprylic/Capric Triglyceride, Arbutin, Glycyrrhiza Glabra (Licorice) Root Extract, Tranexamic Acid, Olea Europaea (Olive) Fruit Oil, Cetearyl Alcohol, Dicetyl Phosphate, Ceteth-10 Phosphate, Dimethicone, Dimethicone Crosspolymer, PEG/PPG-18/18 Dimethicone, Hydrolyzed Verbascum Thapsus Flower, Xylitylglucoside, Anhydroxylitol, Xylitol, Carbomer, Beta Vulgaris Root Extract, Tocopheryl Acetate, Phenoxyethanol, Ethylhexylglycerin, Citric Acid, Sodium Benzoate, Potassium Sorbate, Sodium Hydroxide, Pentaerythrityl Tetra-Di-T-Butyl Hydroxyhydrocinnamate.',3,6,6,4,9);
-INSERT INTO PRODUCTS VALUES(95,'3% Vitamin E Face Moisturizer','Purified Water, Isopropyl Myristate, Emulsifying Wax, Caprylic Capric Triglyceride, Glyceryl Stearate, Cetearyl Alcohol, Glycerine, Tocopherol, Arlacel-165, Disodium Phosphate(Sodium Hydrogen Phosphate), Saccharide Isomerate, Argan Oil, Carbomer, Dimethicone, Aristoflex AVC, Lactic Acid, Sodium PCA, Sodium Benzoate, Euxyl PE 9010 & Triethanolamine.',3,6,6,4,9);
-INSERT INTO PRODUCTS VALUES(96,'4% Urea Deep Moisturizing Cream','Aqua, Isopropyl Myristate, Urea, C15-C19 Alkane, Dimethicone, Glycerin, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera (Date Palm) Extract, Glyceryl Stearate, Phenoxyethanol, Carbomer, Coconut Oil, Stearic Acid, Aloevera Extract, Triethanolamine, Cetostearyl Alcohol, Polyacrylamide, C13-14 Isoparaffin, Laureth-7, Ethoxydiglycol, Di Sodium EDTA, Vitamin E, Ceramide Complex And Lactic Acid',3,2,6,4,9);
-INSERT INTO PRODUCTS VALUES(97,'5% Propylene Oil-Free Moisturizer','Aqua, Propylene Glycol, Caprylic/Capric Triglyceride, Hyaluronic Acid, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera (Date Palm) Extract, Glycerin, Glyceryl Stearate, PEG 100 Stearate, Dimethicone, Phenoxyethanol, Ethylhexylglycerin, Cetostearyl Alcohol, C15-19 Alkane, Stearic Acid, Cetyl Palmitate, Sodium Acrylate/Sodium Acryloyldi methyl Taurate Copolymer,Isohexadecane, Polysorbate 80, Carbomer, Squalene, Trehalose, Hydrolyzed Vegetable Protein, Sodium Hydroxide, Xylitylglucoside, Disodium EDTA, Ceramide NG, Ceramide NP and Glycine Soja(Soybean) Sterols.',3,6,6,4,9);
\ No newline at end of file
+INSERT INTO Products VALUES(1,'Acne Foaming Cream Cleanser','Benzoyl Peroxide 4%, Water, Glycerin, Propylene Glycol, Cocamidopropyl Hydroxysultaine, Sodium C14-16 Olefin Sulfonate, Xanthan Gum, Potassium Hydroxide, Ceramide NP, Ceramide AP, Ceramide EOP, Carbomer, Niacinamide, Glycolic Acid, Sodium Chloride, Sodium Citrate, Sodium Hyaluronate, Sodium Lauroyl Lactylate, Sodium Hydroxide, Cholesterol, Phenoxyethanol, Propanediol, Citric Acid, Tetrasodium EDTA, Diethylhexyl Sodium Sulfosuccinate, Phytosphingosine, Ethylhexylglycerin, Benzoic Acid',1,1,1,1,8);
+INSERT INTO Products VALUES(2,'Acne Control Cleanser','SALICYLIC ACID 2%, WATER, SODIUM LAUROYL SARCOSINATE, COCAMIDOPROPYL HYDROXYSULTAINE, GLYCERIN, NIACINAMIDE, GLUCONOLACTONE, SODIUM METHYL COCOYL TAURATE, PEG-150 PENTAERYTHRITYL TETRASTEARATE, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, CALCIUM GLUCONATE, TRIETHYL CITRATE, SODIUM BENZOATE, SODIUM HYDROXIDE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, TETRASODIUM EDTA, CAPRYLYL GLYCOL, HYDROLYZED HYALURONIC ACID, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, XANTHAN GUM, HECTORITE, PHYTOSPHINGOSINE, BENZOIC ACID',1,1,1,1,1);
+INSERT INTO Products VALUES(3,'Acne Foaming Cream Wash','BENZOYL PEROXIDE 10%, WATER, GLYCERIN, PROPYLENE GLYCOL, COCAMIDOPROPYL HYDROXYSULTAINE, SODIUM C14-16 OLEFIN SULFONATE, POTASSIUM HYDROXIDE, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, NIACINAMIDE, GLYCOLIC ACID, TRIDECETH-6, TRIETHYL CITRATE, SODIUM CITRATE, SODIUM HYALURONATE, SODIUM HYDROXIDE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, PROPANEDIOL, TETRASODIUM EDTA, CAPRYLYL GLYCOL, DIETHYLHEXYL SODIUM SULFOSUCCINATE, PHYTOSPHINGOSINE, XANTHAN GUM, ACRYLATES/C10-30 ALKYL ACRYLATE CROSSPOLYMER, BENZOIC ACID, PEG-30 DIPOLYHYDROXYSTEARATE',1,1,1,1,8);
+INSERT INTO Products VALUES(4,'AM Facial Moisturizing Lotion SPF 30','HOMOSALATE (10%), MERADIMATE (5%), OCTINOXATE (5%), OCTOCRYLENE (2%),  ZINC OXIDE  (6.3%), WATER, NIACINAMIDE, GLYCERIN, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, DIMETHICONE, BHT, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, TRIETHOXYCAPRYLYLSILANE, METHYLPARABEN, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, ALUMINUM STARCH OCTENYLSUCCINATE, DISODIUM EDTA, PROPYLPARABEN, HYDROXYETHYLCELLULOSE, HYDROLYZED HYALURONIC ACID, PHYTOSPHINGOSINE, XANTHAN GUM ',1,2,1,4,5);
+INSERT INTO Products VALUES(5,'AM Facial Moisturizing Lotion SPF 50','HOMOSALATE (8%), ZINC OXIDE (7%), OCTISALATE (5%), OCTOCRYLENE (5%), WATER, GLYCERIN, DIMETHICONE, PROPANEDIOL, BUTYLOCTYL SALICYLATE, STEARETH-20, CELLULOSE, NIACINAMIDE, ETHYLHEXYL METHOXYCRYLENE, STEARETH-2, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, SORBITAN ISOSTEARATE, CARBOMER, GLYCINE SOJA (SOYBEAN) OIL, TRIETHOXYCAPRYLYLSILANE, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, TRIETHYL CITRATE, SODIUM HYALURONATE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, AMMONIUM POLYACRYLOYLDIMETHYL TAURATE, TOCOPHEROL, CHLORPHENESIN, HYDROXYACETOPHENONE, CAPRYLYL GLYCOL, HYDROXYETHYL ACRYLATE/SODIUM ACRYLOYLDIMETHYL TAURATE COPOLYMER, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, PHYTOSPHINGOSINE, XANTHAN GUM, POLYHYDROXYSTEARIC ACID, POLYSORBATE 60, ORYZA SATIVA (RICE) BRAN WAX, BENZOIC ACID, C12-22 ALKYL ACRYLATE/HYDROXYETHYLACRYLATE COPOLYMER',1,2,1,4,5);
+INSERT INTO Products VALUES(6,'Oil Control Moisturizing Gel-Cream','AQUA / WATER / EAU, NIACINAMIDE, GLYCERIN, CETEARYL ISONONANOATE, C14-22 ALCOHOLS, ISOPROPYL MYRISTATE, ZEA MAYS STARCH / CORN STARCH, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, TRIETHYL CITRATE, SILICA, SODIUM HYDROXIDE, SODIUM HYALURONATE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, PHENOXYETHANOL, CITRIC ACID, CAPRYLYL GLYCOL, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, XANTHAN GUM, PHYTOSPHINGOSINE, POLYACRYLATE CROSSPOLYMER-6, BENZOIC ACID, C12-20 ALKYL GLUCOSIDE',1,4,1,4,5);
+INSERT INTO Products VALUES(7,'Resurfacing Retinol Serum','AQUA/WATER/EAU, PROPANEDIOL, DIMETHICONE, CETEARYL ETHYLHEXANOATE, NIACINAMIDE, AMMONIUM POLYACRYLOYLDIMETHYL TAURATE, DIPOTASSIUM GLYCYRRHIZATE, HYDROGENATED LECITHIN, POTASSIUM PHOSPHATE, CERAMIDE NP, CERAMIDE AP, CERAMIDE EOP, CARBOMER, CETEARYL ALCOHOL, BEHENTRIMONIUM METHOSULFATE, DIMETHICONOL, LECITHIN, SODIUM CITRATE, RETINOL, SODIUM HYALURONATE, SODIUM LAUROYL LACTYLATE, CHOLESTEROL, PHENOXYETHANOL, ALCOHOL, ISOPROPYL MYRISTATE, CAPRYLYL GLYCOL, CITRIC ACID, TRISODIUM ETHYLENEDIAMINE DISUCCINATE, PENTYLENE GLYCOL, PHYTOSPHINGOSINE, XANTHAN GUM, POLYSORBATE 20, ETHYLHEXYLGLYCERIN',1,6,1,2,6);
+INSERT INTO Products VALUES(8,'Pro Oil Control Foam wash','Aqua, Zinc Coceth Sulfate, Glycerin, PEG-75, Amyl Cinnamal, Benzyl Benzoate, Citronellol, Dipotassium Glycyrrhizate, Disodium EDTA, Geraniol, Hydroxycitronellal, Linalool, PEG-7 Glyceryl Cocoate, PEG-40 Hydrogenated Castor Oil, PEG-200 Hydrogenated Glyceryl Palmate, Sodium Benzoate, Zinc Gluconate, Perfume. ',1,1,2,1,9);
+INSERT INTO Products VALUES(9,'Oily Skin Cleanser','Aqua, Glycerin, Cocamidopropyl Betaine, Disodium Laureth Sulfosuccinate, Sodium Cocoamphoacetate, Panthenol, Niacinamide, Pantolactone, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Benzoate, Parfum, Amyl Cinnamal, Citronellol, Geraniol, Hydroxycitronellal, Linalool, Sodium Chloride, Citric Acid.',1,4,2,1,9);
+INSERT INTO Products VALUES(10,'Daily exfoliating cleanser','Aqua, Cocamidopropyl Betaine, Disodium Cocoamphodiacetate, Glycerin, Coco-Glucoside, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Bambusa Arundinacea Stem Extract, Citric Acid, Heliotropine, Panthenol, Parfum, Phenoxyethanol, Polyquaternium-10, Sodium Benzoate, Sodium Chloride, Sodium Citrate, Sodium Cocoamphoacetate, Sodium Hydroxide, Tocopheryl Acetate, Xanthan Gum.',1,6,2,1,9);
+INSERT INTO Products VALUES(11,'Bright Healthy Radiance Reveal Creamy Cleanser','Glycerin, Aqua, Stearic Acid, Myristic Acid, Potassium Hydroxide, Lauric Acid, Palmitic Acid, Cocamidopropyl Betaine, Hydrogenated Polyisobutene, Glyceryl Stearate, Anhydroxylitol, Arachidic Acid, Butylene Glycol, Capric Acid, Caprylyl Glycol, Ethylhexylglycerin, Hydrogenated Polydecene, Niacinamide, Oleic Acid, Pancratium Maritimum Extract, Polyquaternium-7, Potassium Benzoate, Sodium Benzoate, Sorbitol, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',4,3,2,1,2);
+INSERT INTO Products VALUES(12,'Gentle Skin Cleanser','Aqua, Glycerin, Cetearyl Alcohol, Panthenol, Niacinamide, Pantolactone, Xanthan Gum, Sodium Cocoyl Isethionate, Sodium Benzoate,  Citric Acid. ',4,2,2,1,9);
+INSERT INTO Products VALUES(13,'Daily Exfoliating Cleanser','Aqua, Cocamidopropyl Betaine, Disodium Cocoamphodiacetate, Glycerin, Coco-Glucoside, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Bambusa Arundinacea Stem Extract, Citric Acid, Heliotropine, Panthenol, Parfum, Phenoxyethanol, Polyquaternium-10, Sodium Benzoate, Sodium Chloride, Sodium Citrate, Sodium Cocoamphoacetate, Sodium Hydroxide, Tocopheryl Acetate, Xanthan Gum.',4,6,2,1,9);
+INSERT INTO Products VALUES(14,'Daily Advance Ultra Hydrating Lotion','Aqua, Glycerin, Isopropyl Palmitate, Helianthus Annuus Seed Oil, Cetearyl Alcohol, Ceteareth-20, Dimethicone, Butyrospermum Parkii Butter, Panthenol, Niacinamide, Tocopheryl Acetate, Sodium PCA, Pantolactone, Glyceryl Stearate, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Benzoate, Potassium Sorbate, Citric Acid.',4,2,2,4,9);
+INSERT INTO Products VALUES(15,'Bright Healthy Radiance Brightness Refresh Toner','Aqua, Butylene Glycol, Niacinamide, Glycerin, 1,2-Hexanediol, Anhydroxylitol, Citric Acid, Ethylhexylglycerin, Hydrolyzed Cicer Seed Extract, Pancratium Maritimum Extract, Rhododendron Chrysanthemum Leaf Extract, Sodium Citrate, Tocopherol, Tricholoma Matsutake Extract, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',4,5,2,3,2);
+INSERT INTO Products VALUES(16,'Bright Healthy Radiance Brightening Lotion','Aqua, Glycerin, Hydrogenated Polydecene, Niacinamide, Cetyl Ethylhexanoate, Caprylic/Capric Triglyceride, 1,2-Hexanediol, Cetearyl Alcohol, Butyrospermum Parkii Butter, Glyceryl Stearate, Butylene Glycol, Anhydroxylitol, Betaine, Citric Acid, Dimethicone, Ethylhexylglycerin, Hyaluronic Acid, Hydrolyzed Cicer Seed Extract, Hydrolyzed Hyaluronic Acid, Palmitic Acid, Pancratium Maritimum Extract, Polyglyceryl-2 Stearate ,Propanediol, Rhododendron Chrysanthemum Leaf Extract, Sodium Hyaluronate, Stearic Acid, Stearyl Alcohol, Tocopherol, Tricholoma Matsutake Extract, Xylitol, Xylitylglucoside',4,6,2,4,9);
+INSERT INTO Products VALUES(17,'Daily Advance Ultra Hydrating Lotion','Aqua, Glycerin, Isopropyl Palmitate, Helianthus Annuus Seed Oil, Cetearyl Alcohol, Ceteareth-20, Dimethicone, Butyrospermum Parkii Butter, Panthenol, Niacinamide, Tocopheryl Acetate, Sodium PCA, Pantolactone, Glyceryl Stearate, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Benzoate, Potassium Sorbate, Citric Acid. FIL.1743.V00',3,5,2,4,9);
+INSERT INTO Products VALUES(18,'Moisturising Cream','Aqua, Glycerin, Petrolatum, Dicaprylyl Ether, Dimethicone, Glyceryl Stearate, Cetyl Alcohol, Helianthus Annuus Seed Oil, Peg-30 Stearate, Panthenol, Niacinamide, Prunus Amygdalus Dulcis Oil, Tocopherol, Tocopheryl Acetate, Pantolactone, Dimethiconol, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Carbomer, Propylene Glycol, Bht, Disodium Edta, Benzyl Alcohol, Phenoxyethanol, Sodium Hydroxide, Citric Acid.',3,2,2,4,9);
+INSERT INTO Products VALUES(19,'Gentle Skin Cleanser','Aqua, Glycerin, Cetearyl Alcohol, Panthenol, Niacinamide, Pantolactone, Xanthan Gum, Sodium Cocoyl Isethionate, Sodium Benzoate,  Citric Acid. ',3,2,2,1,9);
+INSERT INTO Products VALUES(20,'Bright Healthy Radiance Reveal Creamy Cleanser','Glycerin, Aqua, Stearic Acid, Myristic Acid, Potassium Hydroxide, Lauric Acid, Palmitic Acid, Cocamidopropyl Betaine, Hydrogenated Polyisobutene, Glyceryl Stearate, Anhydroxylitol, Arachidic Acid, Butylene Glycol, Capric Acid, Caprylyl Glycol, Ethylhexylglycerin, Hydrogenated Polydecene, Niacinamide, Oleic Acid, Pancratium Maritimum Extract, Polyquaternium-7, Potassium Benzoate, Sodium Benzoate, Sorbitol, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',3,3,2,1,2);
+INSERT INTO Products VALUES(21,'Bright Healthy Radiance Brightness Refresh Toner','Aqua, Butylene Glycol, Niacinamide, Glycerin, 1,2-Hexanediol, Anhydroxylitol, Citric Acid, Ethylhexylglycerin, Hydrolyzed Cicer Seed Extract, Pancratium Maritimum Extract, Rhododendron Chrysanthemum Leaf Extract, Sodium Citrate, Tocopherol, Tricholoma Matsutake Extract, Trisodium Ethylenediamine Disuccinate, Xylitol, Xylitylglucoside',3,5,2,3,2);
+INSERT INTO Products VALUES(22,'Salicylic Acid + LHA 2% Cleanser','Aqua, Glycerin, Cocamidopropyl Betaine, Propanediol, Sodium Lauroyl Methyl Isethionate, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG-150 Pentaerythrityl Tetrastearate, PEG-120 Methyl Glucose Dioleate, Salicylic Acid, Betaine, Avena Sativa (Oat) Kernel Extract, Pentylene Glycol, Capryloyl Salicylic Acid, Panthenol, Allantoin, Zinc PCA, Sodium PCA, Coco-Glucoside, Glyceryl Oleate, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Lactic Acid, Citric Acid, Sodium Citrate, Sodium Hydroxide',1,1,3,1,1);
+INSERT INTO Products VALUES(23,'Salicylic Acid 2% Face Serum','Aqua, Methylpropanediol, Butylene Glycol, Ethoxydiglycol, Dimethyl Isosorbide, Salicylic Acid, Glycerin, Marrubium Vulgare Extract, Pentylene Glycol, Polylysine, Sodium Hyaluronate, Epigallocatechin Gallatyl Glucoside, Hydroxyethylcellulose, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan, Phenoxyethanol, Lactic Acid, Isoceteth-20, Trisodium Ethylenediamine Disuccinate, Ethylhexylglycerin, Oligopeptide-10, Sodium Hydroxide',1,4,3,2,1);
+INSERT INTO Products VALUES(24,'Niacinamide 10% Face Serum','Aqua, Niacinamide, Glycerin, Butylene Glycol, Dimethyl Isosorbide, Propanediol, Ethoxydiglycol, Acetyl Glucosamine, Pseudoalteromonas Ferment Extract, Zinc PCA, Zinc Glycinate, Allantoin, Sodium Hyaluronate, Hydroxyethylcellulose, Phenoxyethanol, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan, Ethylhexylglycerin',1,6,3,2,2);
+INSERT INTO Products VALUES(25,'Vitamin B5 10% Moisturizer','Water/Aqua, Panthenol, Cyclopentasiloxane, Dimethicone Crosspolymer, Glycerin, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG/PPG-18/18 Dimethicone, Hydrogenated Polyisobutene, Magnesium Aspartate, Zinc Gluconate, Copper Gluconate, Sodium Hyaluronate, Betaine, Phenoxyethanol, Pentylene Glycol, Allantoin, Ethylhexylglycerin, Carbomer, Magnesium Sulfate Heptahydrate, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer',1,1,3,4,4);
+INSERT INTO Products VALUES(26,'Alpha Arbutin 2% Face Serum','Aqua, Dimethyl Isosorbide, Ethoxydiglycol, Alpha Arbutin, Lactic Acid, Pentylene Glycol, Ferulic Acid, Sodium Hyaluronate, 4-n-Butylresorcinol, Hydroxyethylcellulose, Triethanolamine, PEG/PPG-17/6 Copolymer, Isoceteth-20, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate',2,6,3,2,4);
+INSERT INTO Products VALUES(27,'Tranexamic 3% Face Serum','Aqua, Avena Sativa (Oat) Kernel Extract, Tranexamic Acid, Dimethyl Isosorbide, Mandelic Acid, Ethoxydiglycol, Pentylene Glycol, Methylpropanediol, Acetyl Glucosamine, Hydroxyphenoxy Propionic Acid, Sodium Hyaluronate, Salicylic Acid, Xanthan Gum, Lecithin, Phenoxyethanol, Sclerotium Gum, Pullulan, Ethylhexylglycerin, Curcuma Longa (Turmeric) Root Extract, Trisodium Ethylenediamine Disuccinate',2,6,3,2,9);
+INSERT INTO Products VALUES(28,'SPF 50 Sunscreen','Aqua, Octocrylene, Diisopropyl Adipate, Propylene Glycol Dicaprylate/Dicaprate, C12-15 Alkyl Benzoate, Diisopropyl Sebacate, Butyl Methoxydibenzoylmethane, Isododecane, Niacinamide, Arachidyl Alcohol, Behenyl Alcohol, Arachidyl Glucoside, Caprylic/Capric Triglyceride, Titanium Dioxide, Ethylhexyl Triazone, PEG-100 Stearate, Glyceryl Stearate, Butylene Glycol, Linoleic Acid, Linolenic Acid, Panthenol, Sodium Hyaluronate, Allantoin, Tocopherol Acetate, Retinol, Polysorbate 20, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer, Xanthan Gum, Polyacrylate Crosspolymer-6, Silica, Acacia Gum, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate',2,6,3,5,2);
+INSERT INTO Products VALUES(29,'Light Fluid SPF 50 Sunscreen','Water/Aqua, Ethylhexyl Methoxycinnamate, Diethylamino Hydroxybenzoyl Hexyl Benzoate, Dimethicone, Ethoxydiglycol, Methylene Bis-Benzotriazolyl Tetramethylbutylphenol, Propylene Glycol Dicaprylate/Dicaprate, Diisopropyl Sebacate, Diisopropyl Adipate, C12-15 Alkyl Benzoate, Butylene Glycol, Phenoxyethanol, VP/Eicosene Copolymer, Isododecane, Pentylene Glycol, Potassium Cetyl Phosphate, Acrylates/Polytrimethylsiloxymethacrylate Copolymer, Decyl Glucoside, Triethanolamine, Carbomer, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Polyacrylate Crosspolymer-6, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Propylene Glycol, Cyclopentasiloxane, Dimethicone Crosspolymer, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Polymethylsilsesquioxane, Xanthan Gum',2,6,3,5,9);
+INSERT INTO Products VALUES(30,'Vitamin B5 10% Moisturizer','Water/Aqua, Panthenol, Cyclopentasiloxane, Dimethicone Crosspolymer, Glycerin, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG/PPG-18/18 Dimethicone, Hydrogenated Polyisobutene, Magnesium Aspartate, Zinc Gluconate, Copper Gluconate, Sodium Hyaluronate, Betaine, Phenoxyethanol, Pentylene Glycol, Allantoin, Ethylhexylglycerin, Carbomer, Magnesium Sulfate Heptahydrate, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer',3,1,3,4,4);
+INSERT INTO Products VALUES(31,'Hyaluronic + PGA 2% Face Serum','Aqua, Sodium Hyaluronate Crosspolymer, Glyceryl Glucoside, Butylene Glycol, Glycerin, Sodium Polyglutamate, Methyl Gluceth-20, Dimethyl Isosorbide, Trehalose, Sodium Hyaluronate, Saccharomyces/Copper Ferment, Hydrolyzed Sodium Hyaluronate, Bacillus/Soybean Ferment Extract, Betaine, Panthenol, Pentylene Glycol, Biosaccharide Gum-1, Sodium Acetylated Hyaluronate, Phenoxyethanol, Chlorphenesin, Ethylhexylglycerin, Ethoxydiglycol',3,6,3,2,4);
+INSERT INTO Products VALUES(32,'Aquaporin Booster 5% Cleanser','Aqua, Glycerin, Glyceryl Glucoside, Diglycerin, Methylpropanediol, Sodium Lauroyl Methyl Isethionate, Sodium Cocoamphoacetate, Disodium Cocoamphodiacetate, Pentylene Glycol, Methyl Gluceth-20, PEG-150 Pentaerythrityl Tetrastearate, Coco-Glucoside, Glyceryl Oleate, Panthenol, Betaine, Sodium PCA, PEG-120 Methyl Glucose Dioleate, Hydrolyzed Wheat Protein, Sodium Hyaluronate Crosspolymer, Avena Sativa (Oat) Kernel Extract, Allantoin, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Lactic Acid',3,6,3,1,4);
+INSERT INTO Products VALUES(33,'Vitamin C 10% Face Serum','Centella Asiatica Leaf Water, 3-O-Ethyl Ascorbic Acid, Ethoxydiglycol, Dimethyl Isosorbide, Gluconolactone, Glycerin, Sodium Gluconate, Acetyl Glucosamine, Sodium Hyaluronate, Pullulan, Hydroxyethylcellulose, Xanthan Gum, Sclerotium Gum, Phenoxyethanol, Ethylhexylglycerin, Lecithin, Lactic Acid',4,6,3,2,5);
+INSERT INTO Products VALUES(34,'Vitamin C + E + Ferulic 16% Face Serum','Aqua, 3-O-Ethyl Ascorbic Acid, Dimethyl Isosorbide, Butylene Glycol, Ethoxydiglycol, Sodium Citrate, Citric Acid, 1,2-Hexanediol, Sodium Gluconate, Ferulic Acid, Coceth-7, Phenoxyethanol, PPG-1-PEG-9 Lauryl Glycol Ether, Fullerenes, Tocopherol Acetate, Ethylhexylglycerin, PEG-40 Hydrogenated Castor Oil, PVP, Trisodium Ethylenediamine Disuccinate',4,6,3,2,5);
+INSERT INTO Products VALUES(35,'Alpha Lipoic + Glycolic 7% Cleanser','Aqua, Glycerin, Cocamidopropyl Betaine, Propanediol, Glycolic Acid, Sodium Lauroyl Methyl Isethionate, Xylitylglucoside, Anhydroxylitol, Xylitol, PEG-150 Pentaerythrityl Tetrastearate, PEG-120 Methyl Glucose Dioleate, Thioctic Acid, Betaine, Pentylene Glycol, Panthenol, Allantoin, Sodium PCA, Coco-Glucoside, Glyceryl Oleate, Phenoxyethanol, Ethylhexylglycerin, Trisodium Ethylenediamine Disuccinate, Lactic Acid, Citric Acid, Sodium Citrate, Sodium Hydroxide',4,6,3,1,10);
+INSERT INTO Products VALUES(36,'Green Tea Pore Cleansing Face Wash','Aqua (Water), Acrylates Copolymer, Sodium Laureth Sulphate (SLES), Glycerin, Cocamidopropyl Betaine, Cocamide DEA, Triethanolamine, Camellia Sinensis (Green Tea) Leaf Extract, Glycolic Acid, Cellulose Beads, Phenoxyethanol, Sodium Gluconate, Ethylhexylglycerin, Fragrance, CI 19140, CI 42090',1,1,4,1,10);
+INSERT INTO Products VALUES(37,'1% Encapsulated Salicylic Acid Foaming Face Wash','Aqua, Sodium Methyl Oleoyl Taurate, Cocamidopropyl Betaine, Glycerin, Coco-Glucoside, Aloe Barbadensis Leaf Juice, Propanediol, Vaccinium Myrtillus (Blueberry) Fruit/Leaf Extract, Saccharum Officinarum (Sugar Cane) Extract, Citrus Aurantium Dulcis (Orange) Fruit Extract, Citrus Limon (Lemon) Fruit Extract, Acer Sachharum (Sugar Maple) Extract, Salicylic Acid, Dextrin, Polydextrose, Amylopectin, Niacinamide, Sodium Cocoyl Apple Amino Acids, Sodium Benzoate, Potassium Sorbate, Olive Oil PEG-7 Esters, Glycolic Acid, Sodium Gluconate, Sodium Benzotrizolyl Butylphenol, Sulfonate, Buteth-3, Tributyl Citrate, Fragrance',1,6,4,1,1);
+INSERT INTO Products VALUES(38,'Green Tea Alcohol-Free Face Toner','Aqua, Glycerin, Peg-40 Hydrogenated Castor Oil, Camellia Sinensis (Green Tea) Leaf Extract, Sodium Gluconate, Phenoxyethanol, Glycolic Acid, Ethylhexylglycerin, Fragrance.',1,1,4,3,10);
+INSERT INTO Products VALUES(39,'Green Tea Day-Light Sunscreen Gel SPF 35 PA+++','Aqua, 1,3-butylene glycol, aloe barbadensis (aloe) leaf juice, ammonium acryloyldimethyltaurate/vp copolymer, argania spinosa (argan) kernel oil, benzophenone-3, betain, butyl methoxydibenzoylmethane, calendula officinalis flower extract, camellia sinensis (green tea) leaf extract, ethylhexyl methoxycinnamate, ethylhexylglycerin, fragrance, glycerin, glycyrrhiza glabra (licorice) root extract, lycium barbarum (goji) fruit extract, niacinamide, phenoxyethanol, phospholipids, sodium gluconate',1,1,4,5,9);
+INSERT INTO Products VALUES(40,'Green Tea Oil-Free Moisturizer','Aqua, Aloe Barbadensis Leaf Juice, Betaine, Isodecyl Neopentanoate, Niacinamide, Willow Bark Extract, Camellia Sinensis (Green Tea) Leaf Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Sodium Hyaluronate, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Glycerin, Sodium Polyacryloyldimethyl Taurate, Squalane, Cyclodextrin, Glyceryl Stearate, PEG-100 Stearate, Sorbitol, Sodium Gluconate',1,4,4,4,9);
+INSERT INTO Products VALUES(41,'Salicylic & Lactic Acid Skin-Smoothing Gel Moisturizer','Aqua, Propylene Glycol, Niacinamide, Carbomer, Glycerin, Saccharum Officinarum (Sugar Cane) Extract, Vaccinium Myrtillus (Bilberry) Fruit Extract, Citrus Aurantium Dulcis (Orange) Fruit Extract, Acer Saccharinum (Sugar Maple) Extract, Citrus Medica Limonum (Lemon) Fruit Extract, Salicylic Acid, Lactic Acid, PEG-40 Hydrogenated Castor Oil, Opuntia Ficus (Prickly Pear) Extract, Tocopheryl Acetate, Phenoxyethanol, Ethylhexylglycerin, Disodium EDTA, Sodium Hydroxide',1,6,4,4,1);
+INSERT INTO Products VALUES(42,'Green Tea Mattifying Moisturizer','Aqua (water), camellia sinensis (green tea) leaf extract, caprylic/capric triglyceride, cetearyl alcohol, ethylhexylglycerine, fragrance, fda approved colours, glycerin, glycolic acid, peg 400, phenoxyethanol, stearic acid, triethanolamine',1,1,4,4,9);
+INSERT INTO Products VALUES(43,'3% Zinc Complex Face Serum with Green Tea','Aqua, Zinc Sebum (Zinc Chloride, Hydrolyzed Wheat Protein, Cinnamomum Zeylanicum (Cinnamon) Bark Extract, Thymus Vulgaris (Thyme) Extract, Malva Sylvestris (Mallow) Flower/Leaf/Stem Extract, Hamamelis Virginiana (Witch Hazel) Leaf Extract), Acnacidol (Butylene Glycol, 10-Hydroxydecanoic Acid, Sebacic Acid), Glycerin, Aloe Barbadensis Leaf Juice, Niacinamide, Ethoxydiglycol, Pentylene Glycol, Rosa Damascena (Rose) Flower Water, Zinc PCA, Camellia Sinensis (Green Tea) Leaf Extract, Vaccinium Myrtillus (Bilberry) Fruit Extract, Saccharum Officinarum (Sugarcane) Extract, Acer Saccharum (Sugar Maple) Extract, Hamamelis Virginiana (Witch Hazel) Water, Glycyrrhiza Glabra (Licorice) Root Extract, Citrus Aurantium Dulcis (Orange) Fruit Extract, Citrus Limon (Lemon) Fruit Extract, Cyclodextrin, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sorbitol, Xanthan Gum, Hydroxyethylcellulose, Sodium Gluconate, Sodium Citrate',1,6,4,2,9);
+INSERT INTO Products VALUES(44,'2% Encapsulated Salicylic Acid Face Serum','Aqua, Glycerin, Salicylic Acid, Dextrin, Polydextrose, Amylopectin, Niacinamide, Propanediol, Pentylene Glycol, Vaccinium Myrtillus (Blueberry) Fruit Extract, Xanthan Gum, Hydroxyethylcellulose, Opuntia Ficus (Prickly Pear) Extract, Rosa Damascena (Rose) Flower Water, Sodium Copper Chlorophyllin, Ethoxydiglycol, Phenoxyethanol, Ethylhexylglycerin, Sodium Hydroxide, Disodium EDTA',1,6,4,2,1);
+INSERT INTO Products VALUES(45,'10% Azelaic Acid & Cica Face Serum','Aqua, Azelaic Acid, Glycerin, Ethoxydiglycol, Centella Asiatica Extract, Niacinamide, Sodium Hyaluronate, Xanthan Gum, Allantoin, Tocopheryl Acetate, Phenoxyethanol, Sodium Metabisulfite',1,6,4,2,9);
+INSERT INTO Products VALUES(46,'10% Niacinamide Face Serum with Rice Water','Aqua, Niacinamide (10%), Oryza Sativa (Rice) Extract, Isodecyl Neopentanoate, Squalane (5%), Sorbitol, Pentylene Glycol, Hydrogenated Polyisobutene, Rice Ferment Filtrate (Sake), Butylene Glycol, Rosa Damascena (Rose) Flower Water, Caffeine (1%), Glycyrrhiza Glabra (Licorice) Root Extract, Glycerin, Acrylates Copolymer, VP/Polycarbamyl Polyglycol Ester, Hydrolyzed Sesame Protein PG-Propyl Methylsilanediol, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Ethylhexyl Olivate, Sodium Acrylates Copolymer, Polyglyceryl-4 Olivate, Cyclodextrin, Sodium Hyaluronate, Tocopheryl Acetate, Sodium Gluconate',2,6,4,2,2);
+INSERT INTO Products VALUES(47,'15% Vitamin C Face Serum with Mandarin','Aqua, Ethyl Ascorbic Acid, Propanediol, Betaine, Isodecyl Neopentanoate, Ethoxy Diglycol, Sodium Citrate, Citric Acid, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Rosa Damascena (Rose) Extract, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Trilaureth-4 Phosphate, Sodium Polyacryloyldimethyl Taurate, Xanthan Gum, Sodium Gluconate',2,6,4,2,5);
+INSERT INTO Products VALUES(48,'2% Niacinamide & Rice Water Gel Cream','Aqua, Glycerin, Pseudoalteromonas Ferment Extract, Niacinamide, Oryza Sativa (Rice) Extract, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Triolein, Opuntia Ficus-Indica (Prickly Pear) Stem Water, Squalane, Ammonium Acryloyldimethyltaurate/VP Copolymer, Ethoxydiglycol, Cyclopentasiloxane, Phenoxyethanol, Ethylhexylglycerin, Disodium EDTA',2,6,4,4,2);
+INSERT INTO Products VALUES(49,'3% Vitamin C Moisturizer with Mandarin','Aqua, Aloe Barbadensis Leaf Juice, Isodecyl Neopentanoate, 3-O-Ethyl Ascorbic Acid, Betaine, Glycerin, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Prunus Amygdalus Dulcis (Sweet Almond) Oil, Squalane, Sodium Citrate, Argania Spinosa (Argan) Kernel Oil, Ammonium Acryloyldimethyltaurate/VP Copolymer, Hydroxyethyl Acrylate/Sodium Acryloyldimethyl Taurate Copolymer, Cyclodextrin, Glyceryl Stearate, PEG-100 Stearate, Sucrose, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sodium Gluconate, Sodium Hyaluronate, Citric Acid, Dilauryl Thiodipropionate, Sodium Benzotriazolyl Butylphenol Sulfonate, Buteth-3, Tributyl Citrate',2,6,4,4,5);
+INSERT INTO Products VALUES(50,'1.5% Vitamin C Face Toner with Mandarin','Aqua, Propanediol, 3-O-Ethyl Ascorbic Acid, Methyl Gluceth-20, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Hamamelis Virginiana (Witch Hazel) Extract, Sodium Citrate, Cyclodextrin, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sodium Gluconate, Sorbitol, Citric Acid, Sucrose',2,6,4,3,5);
+INSERT INTO Products VALUES(51,'Vitamin C Foaming Face Wash with Mandarin','Aqua, Sodium Lauroyl Methyl Isethionate, Cocamidopropyl Betaine, Sodium Methyl Oleoyl Taurate, Lauryl Glucoside, Coco-Glucoside, Glycerin, Methyl Gluceth-20, 3-O-Ethyl Ascorbic Acid, Citrus Reticulata (Mandarin) Peel Extract, Terminalia Ferdinandiana (Kakadu Plum) Fruit Extract, Phenoxyethanol, Ethylhexylglycerin, Sodium Gluconate, Fragrance, Citric Acid',2,6,4,1,5);
+INSERT INTO Products VALUES(52,'2% Niacinamide & Rice Water Gel Cream','Aqua, Glycerin, Pseudoalteromonas Ferment Extract, Niacinamide, Oryza Sativa (Rice) Extract, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Triolein, Opuntia Ficus-Indica (Prickly Pear) Stem Water, Squalane, Ammonium Acryloyldimethyltaurate/VP Copolymer, Ethoxydiglycol, Cyclopentasiloxane, Phenoxyethanol, Ethylhexylglycerin, Disodium EDTA',3,6,4,4,2);
+INSERT INTO Products VALUES(53,'Hello Aloe Caring Day Moisturizer | Aloe Vera Gel Moiturizer','Aqua, Aloe Barbadensis (Aloe) Leaf Juice*, Caprylic Capric Triglyceride, Propanediol, Glyceryl Mono-stearate, Glycerin, Glyceryl Stearate Citrate, Cetearyl Alcohol, Tocopheryl Acetate, Benzyl Alcohol Dehydro Acetic Acid, Potassium Sorbate, Fragrance, Xanthan Gum, Embelica Officinalis (Amla) Extract* * - ingredients from organic farming',3,6,4,4,9);
+INSERT INTO Products VALUES(54,'Hemp & Ceramides Moisturizer With Algae Oil & Aloe Extracts','Aqua, Aloe Barbadensis Leaf Juice, Propanediol, Undecane, Tridecane, Ceramide NP, Ceramide AP, Ceramide EOP, Phytosphingosine, Cholesterol, Sodium Lauroyl Lactylate; Carbomer; Xanthan Gum, Glycerin, Cannabis Sativa (Hemp) Seed Oil, Triolein, Hydrolysed Sodium Hyaluronate, Butyrospermum Parkii (Shea) Butter, Sucrose, Cyclodextrin, Glyceryl Stearate, PEG-100 Stearate, Benzyl Alcohol, Hydroxyacetophenone, Caprylyl Glycol, Sorbitol, Cetearyl Alcohol, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Aminomethyl Propanol, Fragrance, Sodium Gluconate, Carbomer, Dilauryl Thiodipropionate, Tocopheryl Acetate',3,6,4,4,6);
+INSERT INTO Products VALUES(55,'1% Resveratrol & Vitamin C Youthful Glow Moisturizer','Aqua, Isopropyl Myristate, Caprylic/Capric Triglyceride, Glycerin, Glyceryl Stearate, PEG-100 Stearate, Helianthus Annuus (Sunflower) Seed Oil, Glyceryl Monostearate, Hydroxypropyl Cyclodextrin, Cyclodextrin, Polydextrose, Resveratrol, 3-O-Ethyl Ascorbic Acid, Theobroma Cacao (Cocoa) Seed Butter, Butyrospermum Parkii (Shea) Butter, Cetearyl Alcohol, Phenoxyethanol, Ethylhexylglycerin, Oryza Sativa (Rice) Bran Wax, Stearic Acid, Ammonium Acryloyldimethyltaurate/VP Copolymer, Sodium Citrate, Fragrance, Sodium Polyacryloyldimethyl Taurate, Sodium Gluconate, CI 15510, CI 45350, Citric Acid',3,6,4,4,9);
+INSERT INTO Products VALUES(56,'1% Oat & Allantoin Nourishing Face Cream with Vitamin E','Aqua, Glycerin, Helianthus Annuus (Sunflower) Seed Oil, Caprylic/Capric Triglyceride, Undecane, Tridecane, Cetearyl Alcohol, Cetearyl Olivate, Sorbitan Olivate, Avena Sativa (Oat) Kernel Extract, Allantoin, Zea Mays (Corn) Starch, Argania Spinosa (Argan) Kernel Oil, Ammonium Polyacryloyldimethyl Taurate, Glyceryl Monostearate, Polyacrylate Crosspolymer-6, Panthenol, Butyrospermum Parkii (Shea) Butter, Silica, Phenoxyethanol, Ethylhexylglycerin, Pentaerythrityl Tetra-di-t-butyl Hydroxyhydrocinnamate, Oryza Sativa (Rice) Bran Wax, Fragrance, Titanium Dioxide, Sodium Gluconate, Tocopheryl Acetate, Sodium Hydroxide, Citric Acid',3,6,4,4,9);
+INSERT INTO Products VALUES(57,'Salicylic Acid Oil Control Face Wash For Oily Skin - 0.5% Salicylic acid and 1% Niacinamide','Aqua, Cocamidopropyl betaine, Sodium Methyl 2-Sulfolaurate, Disodium 2-Sulfolaurate, Sodium Methyl Cocoyl Taurate, Chamomilla Recutita extract, Aloe Barbadensis extract, Glycerine, Xylitylglucoside , Anhydroxylitol, Xylitol, Niacinamide, Xanthan Gum, Ethylhexylglycerin, Phenoxyethanol, Salicylic Acid, Betaine, Sodium Gluconate.',1,1,5,1,1);
+INSERT INTO Products VALUES(58,'Oil-Free Moisturizer for Oily Skin - 3% NMF Complex + 0.2% Panthenol | Non-Comedogenic Moisturizer','Aqua, Calendula Officinalis (Flower) Extract, Glycerin, Diheptyl Succinate, Aloe barbadensis (Aloe Vera) Extract, Triethanolamine, Phenoxyethanol, Betaine, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Sodium Pyrrolidone Carboxylate, Sodium PCA, Panthenol, Sodium Gluconate, Ethylhexylglycerin, Sodium lactate, PCA, Capryloyl Glycerin/Sebacic Acid Copolymer, Serine, Alanine, Glycine, Glutamic Acid, Lysine HCl, Threonine, Arginine, Proline',1,1,5,4,9);
+INSERT INTO Products VALUES(59,'Breakout Control D.S. Serum - 5% Succinic Acid + 8% PAD','Water (Aqua), Potassium Azeloyl Diglycinate (PAD), Succinic Acid, Citrus Paradisi Fruit extract, Vitis Vinifera Seed Extract, Glycerine, Propylene Glycol, Xylitylglucoside, Anhydroxylitol, Betaine, Sodium Cocyl Amino acids, Sarcosine, Xylitol, Sodium Gluconate, Magnesium Aspartate, Potassium Aspartate, Phenoxyethanol, Triethanolamine, Xanthan Gum, Ethylhexylglycerin, Glucose, Sodium Benzoate, Imidazole urea, Imidazolidinyl urea',1,1,5,2,9);
+INSERT INTO Products VALUES(60,'Breakout Control Serum - 3.3% Potassium Azeloyl Diglycinate','Aqua, Potassium Azeloyl Diglycinate, Camellia Sinensis (Green Tea) Leaf extract, Glycerin, Chamomille Recutita (matricaria) flower extract, Xylitylglucoside, Anhydroxylitol, xylitol, Azardica Indica extract, Phenoxyethanol,  Ethylhexylglycerin, Guar gum, Xanthan gum, Betaine, Sophorolipid, Sodium Gluconate, Lactococcus Ferment Lysate, Triethanolamine',1,6,5,2,9);
+INSERT INTO Products VALUES(61,'2% Salicylic Acid + 3% Niacinamide - Pore Control Serum','Aqua, Propylene Glycol, Niacinamide, Camellia Sinensis (Green Tea) Leaf Extract, Salicylic Acid, Xylitylglucoside, Anhydroxylitol, Xylitol, Piper nigrum (Black pepper) Seed  extract, Triethanolamine, Phenoxyethanol, Ethylhexylglycerin, Xanthan gum, Phyllanthus Emblica (Amla) Fruit Extract, Capryloyl Glycine, Sarcosine, Cinnamomum Zeylanicum bark Extract, Glycerine, Palmaria Palmata extract, EDTA, Sophorolipid',1,1,5,2,2);
+INSERT INTO Products VALUES(62,'Clearing Niacinamide Serum - 5 % Niacinamide + 2 % Alpha Arbutin','Water, Niacinamide, Alpha Arbutin, Dictyopteris Membranacea, Glycerin, Phenoxyethanol, Ethylhexylglycerin, Sodium PCA , Trehalose, Xanthan Gum, Sodium Gluconate',4,6,5,2,2);
+INSERT INTO Products VALUES(63,'Brightening Niacinamide Serum - 10% Niacinamide + 0.3% Alpha Arbutin','Aqua, Niacinamide, Glycerin, Xylitylglucoside (and) anhydroxylitol (and) xylitol, Phenoxyethanol (and) Ethylhexylglycerin, Xanthan gum, Guar gum, Alpha Arbutin, EDTA, Brassica Campestris (Rapeseed) Seed Oil, Glycyrrhiza Glabra (Licorice)Root Extract, Polyglyceryl-3 Diisostearate, Althaea Officinalis Root Extract, Oryza Sativa (Rice) Bran Extract, Palmaria Palmata extract, Bellis Perennis (Daisy) Flower Extract',4,6,5,2,2);
+INSERT INTO Products VALUES(64,'Brightening Moisturizer- 5% Niacinamide + 1% Kojic Acid Dipalmitate','Aqua, Hydrogenated Olive Oil Unsaponifiables (and) Hydrogenated Ethylhexyl Olivate (and) Dibutyl Adipate, Niacinamide, 3-O-Ethyl Ascorbic Acid (and) Water, Polyacrylate-13 (and) Polyisobutene (and) Polysorbate 20, Glycerin, Diheptyl Succinate (and) Capryloyl Glycerin/Sebacic Acid Copolymer, Kojic acid dipalmitate, Phenoxyethanol, Ethylhexylglycerin, C6- C12 Triglycerides, Sodium Acrylate/Sodium Acryloyldimethyl Taurate Copolymer (and) Isohexadecane (and) Polysorbate 80, Niacinamide (and) Citrus Junos Seed Extract (and) Lactobacillus Ferment (and) Scutellaria Baicalensis Root Extract, Lactic Acid, Vitamin E, Sodium Gluconate',4,6,5,4,2);
+INSERT INTO Products VALUES(65,'Niacinamide Brightening Face Wash - 2% Niacinamide + 2% Liquorice root extract','Aqua, Cocoamidopropyl betaine, Sodium Methyl Cocoyl Taurate, Glycerine, Xylitylglucoside, Anhydroxylitol, Xylitol, Sodium Methyl 2-Sulfolaurate,  Disodium 2-Sulfolaurate, Guar Gum, Niacinamide, Glycyrrhiza Glabra (Licorice) Root Extract, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Ethylhexylglycerin, Phenoxyethanol , Triethanolamine, Sodium Gluconate.',4,6,5,1,2);
+INSERT INTO Products VALUES(66,'2% Hyaluronic Acid Serum with 1% Niacinamide | Oil Free Hydrating Face serum','Aqua, Xylitylglucoside, Anhydroxylitol, Xylitol, Hyaluronic Acid, Piper nigrum (black pepper) Seed extract, Butylene Glycol, Niacinamide, Xanthan Gum, Phenoxyethanol, Ethylhexylglycerin, Magnesium Aspartate (and) Zinc Gluconate (and) Copper Gluconate, Sodium Gluconate',3,6,5,4,2);
+INSERT INTO Products VALUES(67,'Hyaluronic Acid Hydrating Face Wash - 0.5% Amino Acids + 0.1% Hyaluronic acid','Aqua, Cocamidopropyl betaine, Sodium Methyl Cocoyl Taurate, Sodium Methyl 2-Sulfolaurate, Disodium 2-Sulfolaurate, Glycerine, Xylitylglucoside, Anhydroxylitol, Xylitol, Maltooligosyl Glucoside, Hydrogenated Starch Hydrolysate, Sodium PCA, Panthenol, Sodium Hyaluronate, Proline, Hydroxyproline, Caprylic Capric Triglyceride, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Triethanolamine, Ethylhexylglycerin, Phenoxyethanol, Silica, Betaine, Sodium Gluconate, Hyaluronic Acid',3,6,5,4,1);
+INSERT INTO Products VALUES(68,'1% Salicylic Acid Gel Face Wash with Salicylic Acid & Witch Hazel ','Aqua, Sodium Alpha Olefin Sulfonate, Cocamidopropyl Betaine, Glycerin, Cocamide MEA, Sodium Lactate, Salicylic Acid, Salix Alba (Willow) Bark Extract, Hamamelis Virginiana (Witch Hazel) Extract, Coco-Glucoside,Glyceryl Oleate, PEG-120 Methyl Glucose Dioleate, Sodium Chloride, Phenoxyethanol, Triethylene glycol & Disodium EDTA.',1,1,6,1,1);
+INSERT INTO Products VALUES(69,'2% Salicylic Acid Gel Face Wash with Salicylic Acid & Witch Hazel','Aqua, Sodium Lauroyl Sarcosinate, Acrylates Copolymer, Disodium Cocoamphodiacetate, Cocamidopropyl Betaine, Glycerin, Caprylyl/Capryl Glucoside, Sodium Methyl Cocoyl Taurate, Salicylic Acid, Glyceryl Glucoside, Sodium Hydroxide, Phenoxyethanol, Ethylhexylglycerin, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera Extract, Betaine, Hamamelis Virginiana Extract, PEG-8, Isostearamidopropyl Ethyldimonium Ethosulfate, Propylene Glycol, Olea Europaea Fruit Oil, Carthamus Tinctorius Seed Oil, Panthenol, Xylitylglucoside, Benzophenone-4, Saccharide Isomerate, Citric Acid, Sodium Citrate, Salix Alba Extract, Sodium Benzoate, Allantoin and Sodium Gluconate.',1,1,6,1,1);
+INSERT INTO Products VALUES(70,'3% AHA+BHA Foaming Daily Face Wash','Aqua, C14-16 Olefin Sulfonate, Mandelic Acid, Maltooligosyl Glucoside (and) Hydrogenated Starch Hydrolysate, Decyl Glucoside, Sodium Lauroyl Sarcosinate, Glycerin, Propylene Glycol, Sodium Hydroxide, Salicylic Acid, Glycolic Acid, Polysorbate 20, Coco-Glucoside (and) Glyceryl Oleate, Cocamidopropyl Hydroxysultaine, PEG-120 Methyl Glucose Dioleate, Allantoin, DMDM- Hydantoin (and) Methylchloroisothiazolinone (and) Methylisothiazolinone.',1,6,6,1,1);
+INSERT INTO Products VALUES(71,'1% Salicylic Acid Foaming Daily Face Wash with Salicylic Acid, Zinc PCA & PHA','Aqua, Cocamidopropyl Betaine, Cocamidopropyl Hydroxysultaine, Maltooligosyl Glucoside and Hydrogenated Starch Hydrolysate, Decyl Glucoside, Disodium Laureth Sulfosuccinate, Sodium Lauroyl Sarcosinate, Propylene Glycol, Glycerin, Salicylic Acid, Zinc PCA, Allantoin, Sodium Cocoyl Isethionate, Coco-Glucoside & Glyceryl Oleate, Glucono Delta Lactone, Capryloyl Glycine & Sarcosine & Cinnamomum Zeylanicum Bark Extract, DMDM Hydantoin & Methylchloroisothiazolinone & Methylisothiazolinone, Sodium Hydroxide.',1,1,6,1,1);
+INSERT INTO Products VALUES(72,'2% Salicylic Acid Serum','Aqua, Salix Alba (Willow) Bark Extract, Salicylic Acid, Ethoxydiglycol, Glycerin, Hamamelis Virginiana (Witch Hazel) Extract, Citric Acid, Phenoxyethanol, Ethylhexylglycerin, Hydroxyethylcellulose, Sodium Benzoate, Potassium Sorbate, Sodium Metabisulfite, Sodium Hydroxide & Disodium EDTA.',1,1,6,2,1);
+INSERT INTO Products VALUES(73,'Sali-Cinamide Anti-Acne Serum with 2% Salicylic Acid & 5% Niacinamide ','Purified Water, Propanediol, Niacinamide, Ethoxydiglycol, Butylene Glycol & Rosa Canina Fruit Extract, PEG-8, Salicylic Acid, Isostearamidopropyl Ethyldimonium Ethosulfate, Sodium Hydroxide, Propylene Glycol, Olea Europaea (Olive) Fruit Oil, Carthamus Tinctorius (Safflower) Seed Oil, Salicylic Acid, Glycerine, Isoamyl Laurate, Alpha Arbutin, Willow Bark Extract, Centella Asiatica Extract, Allantoin, Hydroxyethyl Cellulose, Sodium Hyaluronate, Xylitylglucoside (and) Anhydroxylitol (and) Xylitol, Triethanolamine, Phenoxyethanol (and) Ethylhexylglycerin.',1,6,6,2,1);
+INSERT INTO Products VALUES(74,'1% Salicylic Acid Oil-Free Moisturizer For Face with Oat Extract','Aqua, Caprylic/Capric Triglyceride, Cyclopentasiloxane, Sodium Polyacrylate, Dimethicone, Trideceth-6, PEG/PPG-18/18 Dimethicone, Glycerin, Salicylic Acid, Cetearyl Alcohol, Dicetyl Phosphate, Ceteth-10 Phosphate, Saccharide Isomerate, Avena Sativa (Oat) Bran Extract, Carbomer, Cetyl Alcohol, Stearic Acid, Phenoxyethanol, Ethylhexylglycerin, Sodium Hyaluronate, Citric Acid, Sodium Citrate, Sodium Hydroxide, Butylated Hydroxy Toluene & Disodium EDTA.',1,6,6,4,1);
+INSERT INTO Products VALUES(75,'1% Kojic Acid Face Wash with Niacinamide & Alpha Arbutin','Myristic Acid, Glycerin, Aqua, Potassium Hydroxide, Propylene Glycol, Stearic Acid, Decyl Glucoside, Lauric Acid, Glycol Distearate, Cocamidopropyl Betaine, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera (Date Palm) Extract, Kojic Acid Dipalmitate, Niacinamide, Sodium PCA, Glyceryl Stearate, Phenoxyethanol, Polyquaternium-7, Titanium Dioxide, Sodium Metabisulfite, Butylated Hydroxytoluene, Vitamin E, Pentaerythrityl Tetra-Di-t-Butyl Hydroxyhydrocinnamate, Disodium EDTA, Glyceryl Glucoside and Alpha Arbutin.',2,6,6,1,3);
+INSERT INTO Products VALUES(76,'2% Cica-Glow Daily Face Wash with Tranexamic Acid & Licorice Extract','Aqua, Sodium Lauroyl Sarcosinate, Acrylates Copolymer, Cocamidopropyl Betaine, Aloe Barbadensis Leaf Juice, Centella Asiatica Extract, Decyl Glucoside, Sodium Cocoyl Apple Amino Acids, Xylitylglucoside, Anhydroxylitol, Xylitol, Glycolic Acid, Tranexamic Acid, Glycyrrhiza Glabra Root Extract, Tocopheryl Acetate, Gillet-C, Methylchloroisothiazolinone, Methylisothiazolinone & Sodium Hydroxide.',2,6,6,1,9);
+INSERT INTO Products VALUES(77,'Tran-Zelaic Pigmentation Corrector Face Wash with Tranexamic Acid & Azelaic Acid','Aqua, Acrylates Copolymer, Sodium Lauroyl Sarcosinate, Propanediol, Cocamidopropyl Betaine, Sodium Cocoamphoacetate, Niacinamide, Polyglycerin-3, Sodium Methyl Oleoyl Taurate, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera Extract, Tranexamic Acid, Azelaic Acid, Sodium Cocoyl Apple Amino Acids, Sodium Hydroxide, Saccharide Isomerate, Citric Acid, Sodium Citrate, Phenoxyethanol, Ethylhexylglycerin, Glycolipids, Panthenol, Sodium PCA, Betaine, Benzophenone-4, Sodium Gluconate, Soyethyl Morpholinium Ethosulfate, Lactic Acid and Glycolic Acid.',2,4,6,1,9);
+INSERT INTO Products VALUES(78,'Nia-Ceramide Barrier Repair Face Wash with 2% Niacinamide and 1% Ceramide','Aqua, Sodium Lauroyl Sarcosinate, Acrylates Copolymer, Cocamidopropyl Betaine, Disodium Cocoamphodiacetate, Polyglycerin 3, Propanediol, Niacinamide, Sodium Cocoyl Apple Amino Acids, Glycerin, Glyceryl Glucoside, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera Extract, Laminaria Digitata Extract, Lecithin Hydrogenated, Cetyl-Pg Hydroxyethyl Palmitamide, Ceramide EOP, Ceramide NG, Ceramide NP, Ceramide AS, Ceramide AP, Cholesterol, 1,2-Hexanediol, Sodium Hydroxide, Phenoxyethanol, Ethylhexylglycerin, Glycolipids, Saccharide Isomerate, Citric Acid, Sodium Citrate, Panthenol, Sodium PCA, Natural Betaine, Benzophenone- 4 and Soyethyl Morpholinium Ethosulfate.',2,4,6,1,2);
+INSERT INTO Products VALUES(79,'3% Niacinamide Foaming Face Wash','Aqua, Ethylhexyl Methoxycinnamate, Niacinamide, Cyclopentasiloxane (and) Dimethicone Crosspolymer, Methylene Bis-Benzotriazolyl, Tetramethylbutylphenol, Aqua, Cocamidopropyl Betaine, Cocamidopropyl Hydroxysultaine, Maltooligosyl Glucoside and Hydrogenated Starch Hydrolysate, Decyl Glucoside, Disodium Laureth Sulfosuccinate, Sodium Lauroyl Sarcosinate, Propylene Glycol, Glycerin, Niacinamide, Gluconolactone, Glycolic Acid, Allantoin, Sodium Cocoyl Isethionate, Polysorbate 20, Coco-glucoside & Glyceryl Oleate, Peg-120 Methyl Glucose Dioleate, DMDM Hydantoin & Methylchloroisothiazolinone & Methylisothiazolinone, Sodium Hydroxide',2,6,6,1,2);
+INSERT INTO Products VALUES(80,'7% Glycolic Acid Hydrating Toner with Glycolic Acid & Hyaluronic Acid','Purified Water, Glycolic Acid, Triethanolamine, Glycerine, Sodium Hyaluronate, Hordeum vulgare Seed Extract, Aloe vera Juice, Phenoxyethanol, Ethylhexylglycerine, Allantoin, Hydroxyethylcellulose, Laminaria Digitata Extract & Cetyl-PG Hydroxyethyl Palmitamide & Ceramide 1 & Ceramide 2 & Ceramide 3 & Ceramide 4 & Ceramide 6 II, Olea Europaea (Olive) Leaf Extract, Xylitylglucoside, Anhydroxylitol and Xylitol.',2,4,6,3,10);
+INSERT INTO Products VALUES(81,'2% Alpha Arbutin Face Serum','Aqua, Arbutin, Propanediol, Tartaric Acid, Disodium EDTA, Sodium Sulfite, Sodium Metabisulfite, Glycerin, Butylene Glycol, Saxifraga Sarmentosa Extract, Carica Papaya Fruit Extract, Psidium Guajava Fruit Extract, Ethoxydiglycol, Sodium Hyaluronate, PEG-40 Hydrogenated Castor Oil, Maltodextrin, Punica Granatum Seed Cell Culture Lysate, Polyacrylate Crosspolymer-6, Phenoxyethanol, Chlorphenesin, Glycerin,Disodium EDTA',2,6,6,2,4);
+INSERT INTO Products VALUES(82,'10% Cica-Glow Face Serum with Tranexamic Acid & Kojic Acid','DM Water, Cica Extract, Niacinamide, Tranexamic Acid, Alpha Arbutin, 1,3 - Propanediol, 3-O-Ethyl Ascorbic acid, Gluconolactone, Diethylene Glycol Monoethyl Ether, Phenoxyethanol, Kojic Acid, Citric Acid, Hydroxy Ethyl Cellulose, Ethylhexylglycerin, Sodium Metabisulphite, Xanthan Gum, Sodium Gluconate, Sclerotium Gum, Lecithin, Pullulan, Ferulic Acid, Licorice Extract, Goji Berry Extract & Silica',2,4,6,2,3);
+INSERT INTO Products VALUES(83,'5% Vitamin C Daily Face Serum with Ferulic Acid & Multivitamin','Water, 1,3-Propanediol, 3-0-Ethyl Ascorbic Acid, Diethylene Glycol Monoethyl Ether, Sodium Hyaluronate, Phenoxyethanol, Hydrogenated Castor Oil, D-Panthenol, Ferulic Acid, Hydroxyethylcellulose, Ethylhexylglycerin, Dimethyl Isosorbide, Vitamin E Acetate, Sodium Gluconate, Glycerin, Ascorbyl Palmitate, Potassium Sorbate, Niacinamide, Pyridoxine HCL, Inositol, Biotin, Thiamine HCL & Riboflavin',2,6,6,2,5);
+INSERT INTO Products VALUES(84,'2% Glutathione Face Serum With Glutathione and Tranexamic Acid','Aqua, Ethoxydiglycol, Tranexamic Acid, Glutathione, Methyl Gluceth-20, Tartaric Acid, Glycerin, Butylene Glycol, Saxifraga Sarmentosa Extract, Carica Papaya (Papaya) Fruit Extract, Psidium Guajava Fruit Extract, Propanediol, PEG-40 Hydrogenated Castor Oil, Phenoxyethanol, Triethylene glycol, Hydroxyethyl Cellulose, Curcuma Longa Rhizomes Extract, Tetrahydrocurcumin, Disodium EDTA, Sodium Sulfite & Sodium Metabisulfite',2,6,6,2,9);
+INSERT INTO Products VALUES(85,'C-Cinamide Radiance Serum With 10% Vitamin C & 5% Niacinamide','Purified Water, Propanediol, 3-O-Ethyl Ascorbic Acid, Niacinamide, Diethylene Glycol Monoethyl Ether, Sodium Hyaluronate, Bis-PEG-18 Methyl Ether Dimethyl Silane, Phenoxyethanol Ethylhexylglycerin, Ferulic Acid, Xanthan Gum, Lecithin, Sclerotium Gum, Pullulan & Sodium Gluconate',2,6,6,2,5);
+INSERT INTO Products VALUES(86,'Ceramide + HA Intense Daily Face Moisturizer','Purified Water, Glycerin, Sodium Acrylate/Sodium Acryloyldimethyl Taurate - Copolymer (and) Hydrogenated Polydecene (and) Trideceth-6 (and) Sorbitan Laurate, Cetearyl Alcohol, Caprylic/Capric Triglyceride, Saccharide Isomerate (and) Aqua (and) Citric Acid (and) Sodium Citrate, Behenyl Alcohol, Sorbitan Stearate (and) Sucrose Cocoate, Cetearyl Alcohol (and) Dicetyl Phosphate (and) Ceteth-10 Phosphate, Cyclopentasiloxane (and) Phenyl Trimethicone (and) Dimethiconol (and) C12-15 Alkyl Benzoate (and) Dimethicone Crosspolymer, Phenoxyethanol (and) Ethylhexylglycerin, Xylitylglucoside (and) Anhydroxylitol (and) Xylitol, Avena Sativa (Oat) Kernel Flour, Sodium Hyaluronate (and) Aqua (and) Phenoxyethanol, Panthenol, Ceramide 3, Ceramide 6 II, Ceramide 1, Phytosphingosine, Cholesterol, Sodium Lauroyl Lactylate, Carbomer, Xanthan Gum, Disodium EDTA & Pentaerythrityl Tetra-Di-T-Butyl Hydroxyhydrocinnamate.',2,4,6,4,6);
+INSERT INTO Products VALUES(87,'5% Vitamin C Oil-Free Daily Face Moisturizer for Skin Radiance','Aqua, 3-O-Ethyl Ascorbic Acid, Glycerin, Isodecyl Neopentanoate, Cetearyl Olivate (and) Sorbitan Olivate, Propanediol, Dicaprylyl Carbonate, C15-19 Alkane, Cetyl Alcohol, Polyacrylate Crosspolymer-11, Xylitol, Phenoxyethanol (and) Ethylhexylglycerin, Saccharide Isomerate (and) Aqua (and) Citric Acid (and) Sodium Citrate, Betaine and Disodium Ethylenediaminetetraacetic acid.',2,4,6,4,5);
+INSERT INTO Products VALUES(88,'1% Hyaluronic Long Lasting Sunscreen SPF 50 & PA++++ with Hyaluronic Acid & Vitamin E','Aqua, Cyclopentasiloxane, Ethylhexyl Methoxycinnamate, Titanium Dioxide, Diethylhexyl Butamido Triazone, Cetyl PEG/PPG-10/1 Dimethicone, Aluminum Chlorohydrate, Dimethicone/Vinyl Dimethicone Crosspolymer, Zinc Oxide, Coco-Caprylate/Caprate, Polyglyceryl-3 Polyricinoleate, Isostearic Acid, Isododecane, Disteardimonium Hectorite, Propylene Carbonate, Bis-Ethylhexyloxyphenol Methoxyphenyl Triazine, Hyaluronic Acid, Physalis Angulata Extract, Caprylic/Capric Triglyceride, Vitamin E, Fructooligosaccharides, Beta Vulgaris Root Extract, Glycerin, Butylene Glycol, Sodium Benzoate, Phenoxyethanol',2,6,6,5,4);
+INSERT INTO Products VALUES(89,'Ultra Light Zinc Mineral Sunscreen with SPF 50','Purified Water, Zinc Oxide, C12-15 Alkyl Benzoate, Isostearic Acid, Polyhydroxystearic Acid, Cyclopentasiloxane, PEG/PPG-18/18 Dimethicone, Caprylyl Methicone, PEG-12 Dimethicone/PPG-20 Crosspolymer, Silica, Glycerin, Dimethicone/Vinyl Dimethicone Crosspolymer, Cetyl PEG/PPG-10/1 Dimethicone, Sodium Chloride, Polymethylsilsesquioxane, Caprylyl Glycol, Methylpropanediol, Didecyldimonium Chloride, Polyquaternium-80, Xanthan Gum and Magnesium Aluminium Silicate.',2,6,6,5,9);
+INSERT INTO Products VALUES(90,'2% Vitamin C Gel Daily Face Wash with Vitamin C, Rosehip & Orange Peel Extract','Purified Water, Cocamidopropyl Betaine, Sodium Laureth-5 Carboxylate, Decyl Glucoside, Acrylates Copolymer, Coco Glucoside, Cocamide Monoethanolamine, Glycerin, Propanediol, Sodium Ascorbyl Phosphate, Polysorbate 20, Rosehip Extract, Orange Peel Extract, Citric Acid, Sodium Hydroxide, Methylchloroisothiazolinone & Methylisothiazolinone and Ethylenediaminetetraacetic acid.',3,3,6,1,1);
+INSERT INTO Products VALUES(91,'2% Niacinamide Gentle Skin Cleanser','Aqua, Cetostearyl Alcohol, Glycerin, Niacinamide, Sodium Cocoyl Isethionate, Phenoxyethanol Ethylhexylglycerin, Cocamidopropyl Betaine, Xanthan Gum, Cica Extract, Cetyl Alcohol, Carbomer, Saccharide Isomerate, Sodium Gluconate, Ceramide Complex, Oatmeal Extract, Vitamin E, Citric Acid and Sodium Citrate.',3,2,6,1,1);
+INSERT INTO Products VALUES(92,'Creamy Cleanser ','Purified Water, Cetyl Alcohol, Propylene Glycol, Phenoxyethanol, Sodium Lauryl Sulphate, Stearyl Alcohol, Polysorbate-80',3,5,6,1,1);
+INSERT INTO Products VALUES(93,'4% Ceramide Barrier Repair Moisturizer','Aqua, Ceramide Complex, Caprylic/Capric Triglyceride, Niacinamide, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera extract, Sodium Acrylates Copolymer, Lecithin, Propylene Glycol, Polyglycerin-3, Glyceryl Stearate, Xylitylglucoside, Dimethicone, Phenoxyethanol, Ethylhexylglycerin, Shea Butter Glycerides, Cetearyl Olivate, Sorbitan Olivate, Sodium Benzoate, Stearic Acid, Acrylates/C10-30 Alkyl Acrylate Crosspolymer, Disodium EDTA, Sodium Hydroxide, Hyaluronic Acid, Glycerin, Ligustrum Lucidum Seed Extract, Fucus Vesiculosus Extract, Butylene Glycol, 1,2-Hexanediol and Tocopheryl Acetate',3,6,6,4,6);
+INSERT INTO Products VALUES(94,'5% Cica-Glow Daily Face Moisturizer','Aqua, Centella Asiatica Extract, Cyclopentasiloxane, Glycerin, Caprylic/Capric Triglyceride, Arbutin, Glycyrrhiza Glabra (Licorice) Root Extract, Tranexamic Acid, Olea Europaea (Olive) Fruit Oil, Cetearyl Alcohol, Dicetyl Phosphate, Ceteth-10 Phosphate, Dimethicone, Dimethicone Crosspolymer, PEG/PPG-18/18 Dimethicone, Hydrolyzed Verbascum Thapsus Flower, Xylitylglucoside, Anhydroxylitol, Xylitol, Carbomer, Beta Vulgaris Root Extract, Tocopheryl Acetate, Phenoxyethanol, Ethylhexylglycerin, Citric Acid, Sodium Benzoate, Potassium Sorbate, Sodium Hydroxide, Pentaerythrityl Tetra-Di-T-Butyl Hydroxyhydrocinnamate.',3,6,6,4,9);
+INSERT INTO Products VALUES(95,'3% Vitamin E Face Moisturizer','Purified Water, Isopropyl Myristate, Emulsifying Wax, Caprylic Capric Triglyceride, Glyceryl Stearate, Cetearyl Alcohol, Glycerine, Tocopherol, Arlacel-165, Disodium Phosphate(Sodium Hydrogen Phosphate), Saccharide Isomerate, Argan Oil, Carbomer, Dimethicone, Aristoflex AVC, Lactic Acid, Sodium PCA, Sodium Benzoate, Euxyl PE 9010 & Triethanolamine.',3,6,6,4,9);
+INSERT INTO Products VALUES(96,'4% Urea Deep Moisturizing Cream','Aqua, Isopropyl Myristate, Urea, C15-C19 Alkane, Dimethicone, Glycerin, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera (Date Palm) Extract, Glyceryl Stearate, Phenoxyethanol, Carbomer, Coconut Oil, Stearic Acid, Aloevera Extract, Triethanolamine, Cetostearyl Alcohol, Polyacrylamide, C13-14 Isoparaffin, Laureth-7, Ethoxydiglycol, Di Sodium EDTA, Vitamin E, Ceramide Complex And Lactic Acid',3,2,6,4,9);
+INSERT INTO Products VALUES(97,'5% Propylene Oil-Free Moisturizer','Aqua, Propylene Glycol, Caprylic/Capric Triglyceride, Hyaluronic Acid, Xylitol, Glucose, Anhydroxylitol, Phoenix Dactylifera (Date Palm) Extract, Glycerin, Glyceryl Stearate, PEG 100 Stearate, Dimethicone, Phenoxyethanol, Ethylhexylglycerin, Cetostearyl Alcohol, C15-19 Alkane, Stearic Acid, Cetyl Palmitate, Sodium Acrylate/Sodium Acryloyldi methyl Taurate Copolymer,Isohexadecane, Polysorbate 80, Carbomer, Squalene, Trehalose, Hydrolyzed Vegetable Protein, Sodium Hydroxide, Xylitylglucoside, Disodium EDTA, Ceramide NG, Ceramide NP and Glycine Soja(Soybean) Sterols.',3,6,6,4,9);
\ No newline at end of file
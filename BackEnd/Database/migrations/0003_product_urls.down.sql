@@ -0,0 +1,2 @@
+ALTER TABLE Products DROP COLUMN Image_URL;
+ALTER TABLE Products DROP COLUMN Product_URL;
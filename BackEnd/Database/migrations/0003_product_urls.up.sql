@@ -0,0 +1,3 @@
+/* product page and image links read by the selection endpoints */
+ALTER TABLE Products ADD COLUMN Product_URL nvarchar(500) NOT NULL DEFAULT '';
+ALTER TABLE Products ADD COLUMN Image_URL nvarchar(500) NOT NULL DEFAULT '';
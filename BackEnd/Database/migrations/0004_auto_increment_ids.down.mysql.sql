@@ -0,0 +1,8 @@
+SET FOREIGN_KEY_CHECKS = 0;
+ALTER TABLE Products MODIFY Product_ID int NOT NULL;
+ALTER TABLE Key_Ingredients MODIFY Key_Ingredients_ID int NOT NULL;
+ALTER TABLE Product_Type MODIFY Product_Type_ID int NOT NULL;
+ALTER TABLE Brand MODIFY Brand_ID int NOT NULL;
+ALTER TABLE Skin_Type MODIFY Skin_Type_ID int NOT NULL;
+ALTER TABLE Concern MODIFY Concern_ID int NOT NULL;
+SET FOREIGN_KEY_CHECKS = 1;
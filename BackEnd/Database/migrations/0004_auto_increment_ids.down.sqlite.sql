@@ -0,0 +1,37 @@
+/* Rebuild the tables with the client-assigned keys they were created with */
+PRAGMA foreign_keys = OFF;
+CREATE TABLE Concern_New (Concern_ID int primary key, Concern nvarchar(100));
+INSERT INTO Concern_New (Concern_ID, Concern) SELECT Concern_ID, Concern FROM Concern;
+DROP TABLE Concern;
+ALTER TABLE Concern_New RENAME TO Concern;
+CREATE TABLE Skin_Type_New (Skin_Type_ID int primary key, Skin_Type nvarchar(100));
+INSERT INTO Skin_Type_New (Skin_Type_ID, Skin_Type) SELECT Skin_Type_ID, Skin_Type FROM Skin_Type;
+DROP TABLE Skin_Type;
+ALTER TABLE Skin_Type_New RENAME TO Skin_Type;
+CREATE TABLE Brand_New (Brand_ID int primary key, Brand nvarchar(50));
+INSERT INTO Brand_New (Brand_ID, Brand) SELECT Brand_ID, Brand FROM Brand;
+DROP TABLE Brand;
+ALTER TABLE Brand_New RENAME TO Brand;
+CREATE TABLE Product_Type_New (Product_Type_ID int primary key, Product_Type nvarchar(50));
+INSERT INTO Product_Type_New (Product_Type_ID, Product_Type) SELECT Product_Type_ID, Product_Type FROM Product_Type;
+DROP TABLE Product_Type;
+ALTER TABLE Product_Type_New RENAME TO Product_Type;
+CREATE TABLE Key_Ingredients_New (Key_Ingredients_ID int primary key, Key_Ingredients nvarchar(50));
+INSERT INTO Key_Ingredients_New (Key_Ingredients_ID, Key_Ingredients) SELECT Key_Ingredients_ID, Key_Ingredients FROM Key_Ingredients;
+DROP TABLE Key_Ingredients;
+ALTER TABLE Key_Ingredients_New RENAME TO Key_Ingredients;
+CREATE TABLE Products_New (Product_ID int primary key, Product_Name nvarchar(200),
+                           All_Ingredients nvarchar(10000), Concern_ID int,
+                           Skin_Type_ID int, Brand_ID int,
+                           Product_Type_ID int, Key_Ingredients_ID int,
+                           Product_URL nvarchar(500) NOT NULL DEFAULT '',
+                           Image_URL nvarchar(500) NOT NULL DEFAULT '',
+                           FOREIGN KEY(Concern_ID) REFERENCES Concern(Concern_ID),
+                           FOREIGN KEY(Skin_Type_ID) REFERENCES Skin_Type(Skin_Type_ID),
+                           FOREIGN KEY(Brand_ID) REFERENCES Brand(Brand_ID),
+                           FOREIGN KEY(Product_Type_ID) REFERENCES Product_Type(Product_Type_ID),
+                           FOREIGN KEY(Key_Ingredients_ID) REFERENCES Key_Ingredients(Key_Ingredients_ID));
+INSERT INTO Products_New (Product_ID, Product_Name, All_Ingredients, Concern_ID, Skin_Type_ID, Brand_ID, Product_Type_ID, Key_Ingredients_ID, Product_URL, Image_URL) SELECT Product_ID, Product_Name, All_Ingredients, Concern_ID, Skin_Type_ID, Brand_ID, Product_Type_ID, Key_Ingredients_ID, Product_URL, Image_URL FROM Products;
+DROP TABLE Products;
+ALTER TABLE Products_New RENAME TO Products;
+PRAGMA foreign_keys = ON;
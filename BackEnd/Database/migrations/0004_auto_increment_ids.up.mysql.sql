@@ -0,0 +1,9 @@
+/* IDs are assigned by the database; the foreign keys keep pointing at the same int columns */
+SET FOREIGN_KEY_CHECKS = 0;
+ALTER TABLE Concern MODIFY Concern_ID int NOT NULL AUTO_INCREMENT;
+ALTER TABLE Skin_Type MODIFY Skin_Type_ID int NOT NULL AUTO_INCREMENT;
+ALTER TABLE Brand MODIFY Brand_ID int NOT NULL AUTO_INCREMENT;
+ALTER TABLE Product_Type MODIFY Product_Type_ID int NOT NULL AUTO_INCREMENT;
+ALTER TABLE Key_Ingredients MODIFY Key_Ingredients_ID int NOT NULL AUTO_INCREMENT;
+ALTER TABLE Products MODIFY Product_ID int NOT NULL AUTO_INCREMENT;
+SET FOREIGN_KEY_CHECKS = 1;
@@ -0,0 +1 @@
+DROP TABLE Idempotency_Key;
@@ -0,0 +1,4 @@
+/* responses to create requests sent with an Idempotency-Key, replayed when the request is retried */
+CREATE TABLE Idempotency_Key (Idempotency_Key nvarchar(255) primary key, Request_Hash char(64) NOT NULL,
+                              Status_Code int NOT NULL DEFAULT 0, Location nvarchar(500) NOT NULL DEFAULT '',
+                              Response_Body mediumtext, Created_At bigint NOT NULL);
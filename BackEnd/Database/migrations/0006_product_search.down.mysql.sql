@@ -0,0 +1,4 @@
+DROP INDEX Key_Ingredients_Search ON Key_Ingredients;
+DROP INDEX Brand_Search ON Brand;
+DROP INDEX Products_Ingredients_Search ON Products;
+DROP INDEX Products_Name_Search ON Products;
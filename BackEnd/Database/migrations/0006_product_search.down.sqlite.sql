@@ -0,0 +1,6 @@
+DROP TRIGGER Key_Ingredients_Search_Update;
+DROP TRIGGER Brand_Search_Update;
+DROP TRIGGER Products_Search_Delete;
+DROP TRIGGER Products_Search_Update;
+DROP TRIGGER Products_Search_Insert;
+DROP TABLE Product_Search;
@@ -0,0 +1,5 @@
+/* one index per searched column, so each can be weighted separately when ranking */
+CREATE FULLTEXT INDEX Products_Name_Search ON Products (Product_Name);
+CREATE FULLTEXT INDEX Products_Ingredients_Search ON Products (All_Ingredients);
+CREATE FULLTEXT INDEX Brand_Search ON Brand (Brand);
+CREATE FULLTEXT INDEX Key_Ingredients_Search ON Key_Ingredients (Key_Ingredients);
@@ -0,0 +1,33 @@
+/* full-text index of each product, keyed by Product_ID as rowid and kept current by triggers */
+CREATE VIRTUAL TABLE Product_Search USING fts5(Product_Name, All_Ingredients, Brand, Key_Ingredients,
+                                                tokenize = 'unicode61 remove_diacritics 2');
+INSERT INTO Product_Search (rowid, Product_Name, All_Ingredients, Brand, Key_Ingredients)
+SELECT p.Product_ID, p.Product_Name, p.All_Ingredients, COALESCE(b.Brand, ''), COALESCE(k.Key_Ingredients, '')
+FROM Products p
+LEFT JOIN Brand b ON p.Brand_ID = b.Brand_ID
+LEFT JOIN Key_Ingredients k ON p.Key_Ingredients_ID = k.Key_Ingredients_ID;
+
+CREATE TRIGGER Products_Search_Insert AFTER INSERT ON Products BEGIN
+    INSERT INTO Product_Search (rowid, Product_Name, All_Ingredients, Brand, Key_Ingredients)
+    VALUES (NEW.Product_ID, NEW.Product_Name, NEW.All_Ingredients,
+            COALESCE((SELECT Brand FROM Brand WHERE Brand_ID = NEW.Brand_ID), ''),
+            COALESCE((SELECT Key_Ingredients FROM Key_Ingredients WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID), ''));
+END;
+CREATE TRIGGER Products_Search_Update AFTER UPDATE ON Products BEGIN
+    DELETE FROM Product_Search WHERE rowid = OLD.Product_ID;
+    INSERT INTO Product_Search (rowid, Product_Name, All_Ingredients, Brand, Key_Ingredients)
+    VALUES (NEW.Product_ID, NEW.Product_Name, NEW.All_Ingredients,
+            COALESCE((SELECT Brand FROM Brand WHERE Brand_ID = NEW.Brand_ID), ''),
+            COALESCE((SELECT Key_Ingredients FROM Key_Ingredients WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID), ''));
+END;
+CREATE TRIGGER Products_Search_Delete AFTER DELETE ON Products BEGIN
+    DELETE FROM Product_Search WHERE rowid = OLD.Product_ID;
+END;
+CREATE TRIGGER Brand_Search_Update AFTER UPDATE OF Brand ON Brand BEGIN
+    UPDATE Product_Search SET Brand = NEW.Brand
+    WHERE rowid IN (SELECT Product_ID FROM Products WHERE Brand_ID = NEW.Brand_ID);
+END;
+CREATE TRIGGER Key_Ingredients_Search_Update AFTER UPDATE OF Key_Ingredients ON Key_Ingredients BEGIN
+    UPDATE Product_Search SET Key_Ingredients = NEW.Key_Ingredients
+    WHERE rowid IN (SELECT Product_ID FROM Products WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID);
+END;
@@ -0,0 +1 @@
+DROP TABLE Ingredient_Synonym;
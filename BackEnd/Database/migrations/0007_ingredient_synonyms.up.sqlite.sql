@@ -0,0 +1,31 @@
+/* alternative spellings and names of ingredients, each mapped to the one name used across the API */
+CREATE TABLE Ingredient_Synonym (Synonym_ID INTEGER PRIMARY KEY AUTOINCREMENT, Synonym nvarchar(100) NOT NULL COLLATE NOCASE,
+                                 Canonical nvarchar(100) NOT NULL, UNIQUE (Synonym));
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Aqua', 'Water');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Purified Water', 'Water');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Deionized Water', 'Water');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Demineralized Water', 'Water');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Eau', 'Water');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Glycerine', 'Glycerin');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Glycerol', 'Glycerin');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Sodium Hyaluronate', 'Hyaluronic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Hydrolyzed Hyaluronic Acid', 'Hyaluronic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Hydrolyzed Sodium Hyaluronate', 'Hyaluronic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Sodium Hyaluronate Crosspolymer', 'Hyaluronic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Nicotinamide', 'Niacinamide');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Vitamin B3', 'Niacinamide');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Vitamin C', 'Ascorbic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('L-Ascorbic Acid', 'Ascorbic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Vitamin E', 'Tocopherol');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Vitamin A', 'Retinol');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('D-Panthenol', 'Panthenol');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Dexpanthenol', 'Panthenol');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Provitamin B5', 'Panthenol');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Pro-Vitamin B5', 'Panthenol');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Parfum', 'Fragrance');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Perfume', 'Fragrance');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Cica', 'Centella Asiatica Extract');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Centella Asiatica', 'Centella Asiatica Extract');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Gotu Kola', 'Centella Asiatica Extract');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('BHA', 'Salicylic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('CI 77891', 'Titanium Dioxide');
@@ -0,0 +1 @@
+DROP TABLE Product_Ingredient;
@@ -0,0 +1,8 @@
+/* each product's All_Ingredients list, parsed into one row per ingredient in listed order. Rows are written by the
+   server, which also fills the table for existing products on start-up */
+CREATE TABLE Product_Ingredient (Product_ID int NOT NULL, Position int NOT NULL, Ingredient nvarchar(255) NOT NULL,
+                                 Listed_As nvarchar(255) NOT NULL, Concentration decimal(6,3),
+                                 May_Contain boolean NOT NULL DEFAULT 0,
+                                 PRIMARY KEY (Product_ID, Position),
+                                 FOREIGN KEY (Product_ID) REFERENCES Products(Product_ID) ON DELETE CASCADE);
+CREATE INDEX Product_Ingredient_Name ON Product_Ingredient (Ingredient);
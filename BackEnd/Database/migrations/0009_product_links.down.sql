@@ -0,0 +1,3 @@
+DROP TABLE Product_Key_Ingredient;
+DROP TABLE Product_Skin_Type;
+DROP TABLE Product_Concern;
@@ -0,0 +1,25 @@
+/* every concern, skin type and key ingredient a product is linked to. The single columns on Products stay as each
+   product's primary link, which is always also listed here */
+CREATE TABLE Product_Concern (Product_ID int NOT NULL, Concern_ID int NOT NULL,
+                              PRIMARY KEY (Product_ID, Concern_ID),
+                              FOREIGN KEY (Product_ID) REFERENCES Products(Product_ID) ON DELETE CASCADE,
+                              FOREIGN KEY (Concern_ID) REFERENCES Concern(Concern_ID));
+CREATE TABLE Product_Skin_Type (Product_ID int NOT NULL, Skin_Type_ID int NOT NULL,
+                                PRIMARY KEY (Product_ID, Skin_Type_ID),
+                                FOREIGN KEY (Product_ID) REFERENCES Products(Product_ID) ON DELETE CASCADE,
+                                FOREIGN KEY (Skin_Type_ID) REFERENCES Skin_Type(Skin_Type_ID));
+CREATE TABLE Product_Key_Ingredient (Product_ID int NOT NULL, Key_Ingredients_ID int NOT NULL,
+                                     PRIMARY KEY (Product_ID, Key_Ingredients_ID),
+                                     FOREIGN KEY (Product_ID) REFERENCES Products(Product_ID) ON DELETE CASCADE,
+                                     FOREIGN KEY (Key_Ingredients_ID) REFERENCES Key_Ingredients(Key_Ingredients_ID));
+CREATE INDEX Product_Concern_Concern ON Product_Concern (Concern_ID);
+CREATE INDEX Product_Skin_Type_Skin_Type ON Product_Skin_Type (Skin_Type_ID);
+CREATE INDEX Product_Key_Ingredient_Key_Ingredient ON Product_Key_Ingredient (Key_Ingredients_ID);
+
+/* each product starts out linked to what its single columns name */
+INSERT INTO Product_Concern (Product_ID, Concern_ID)
+SELECT Product_ID, Concern_ID FROM Products WHERE Concern_ID IN (SELECT Concern_ID FROM Concern);
+INSERT INTO Product_Skin_Type (Product_ID, Skin_Type_ID)
+SELECT Product_ID, Skin_Type_ID FROM Products WHERE Skin_Type_ID IN (SELECT Skin_Type_ID FROM Skin_Type);
+INSERT INTO Product_Key_Ingredient (Product_ID, Key_Ingredients_ID)
+SELECT Product_ID, Key_Ingredients_ID FROM Products WHERE Key_Ingredients_ID IN (SELECT Key_Ingredients_ID FROM Key_Ingredients);
@@ -0,0 +1,2 @@
+ALTER TABLE Skin_Type DROP COLUMN Is_Wildcard;
+ALTER TABLE Concern DROP COLUMN Is_Wildcard;
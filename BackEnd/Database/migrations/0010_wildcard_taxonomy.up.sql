@@ -0,0 +1,6 @@
+/* wildcard concerns and skin types apply to every other one, so products made for all skin types are offered for
+   each of them */
+ALTER TABLE Concern ADD COLUMN Is_Wildcard boolean NOT NULL DEFAULT 0;
+ALTER TABLE Skin_Type ADD COLUMN Is_Wildcard boolean NOT NULL DEFAULT 0;
+UPDATE Concern SET Is_Wildcard = 1 WHERE Concern = 'Multipurpose';
+UPDATE Skin_Type SET Is_Wildcard = 1 WHERE Skin_Type = 'All Types';
@@ -0,0 +1 @@
+DROP TABLE Condition_Concern;
@@ -0,0 +1,7 @@
+/* the conditions the skin analysis model detects, each mapped to the concern products are selected for */
+CREATE TABLE Condition_Concern (Condition_ID int NOT NULL AUTO_INCREMENT primary key, Label nvarchar(100) NOT NULL,
+                                Concern_ID int NOT NULL, UNIQUE (Label),
+                                FOREIGN KEY (Concern_ID) REFERENCES Concern(Concern_ID));
+INSERT INTO Condition_Concern (Label, Concern_ID) VALUES ('Acne', 1);
+INSERT INTO Condition_Concern (Label, Concern_ID) VALUES ('Pigmentation', 2);
+INSERT INTO Condition_Concern (Label, Concern_ID) VALUES ('Dark Spots', 2);
@@ -0,0 +1,3 @@
+ALTER TABLE Key_Ingredients DROP COLUMN Time_Of_Day;
+ALTER TABLE Product_Type DROP COLUMN Time_Of_Day;
+ALTER TABLE Product_Type DROP COLUMN Step_Order;
@@ -0,0 +1,11 @@
+/* product types become the steps of a skincare routine, applied in Step_Order. Steps and key ingredients may be kept
+   to the morning or evening routine: sunscreen is only needed in the day, and retinol is only used at night */
+ALTER TABLE Product_Type ADD COLUMN Step_Order int;
+ALTER TABLE Product_Type ADD COLUMN Time_Of_Day varchar(2) NOT NULL DEFAULT '';
+ALTER TABLE Key_Ingredients ADD COLUMN Time_Of_Day varchar(2) NOT NULL DEFAULT '';
+UPDATE Product_Type SET Step_Order = 1 WHERE Product_Type_ID = 1;
+UPDATE Product_Type SET Step_Order = 2 WHERE Product_Type_ID = 3;
+UPDATE Product_Type SET Step_Order = 3 WHERE Product_Type_ID = 2;
+UPDATE Product_Type SET Step_Order = 4 WHERE Product_Type_ID = 4;
+UPDATE Product_Type SET Step_Order = 5, Time_Of_Day = 'AM' WHERE Product_Type_ID = 5;
+UPDATE Key_Ingredients SET Time_Of_Day = 'PM' WHERE Key_Ingredients = 'Retinol';
@@ -0,0 +1,2 @@
+DELETE FROM Ingredient_Synonym WHERE Synonym IN ('Vitamin - C', 'Ethyl Ascorbic Acid', '3-O-Ethyl Ascorbic Acid');
+DROP TABLE Ingredient_Interaction;
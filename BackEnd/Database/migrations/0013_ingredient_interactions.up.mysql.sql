@@ -0,0 +1,33 @@
+/* how pairs of ingredients behave when used in the same routine. Names are matched canonically against key
+   ingredients and parsed ingredient lists; each pair is stored with the names in alphabetical order */
+CREATE TABLE Ingredient_Interaction (Interaction_ID int NOT NULL AUTO_INCREMENT primary key,
+                                     Ingredient_A nvarchar(100) NOT NULL,
+                                     Ingredient_B nvarchar(100) NOT NULL,
+                                     Severity varchar(10) NOT NULL, Reason nvarchar(255) NOT NULL,
+                                     UNIQUE (Ingredient_A, Ingredient_B));
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Benzoyl Peroxide', 'Retinol', 'conflict', 'Benzoyl peroxide can break down retinol, and together they are very drying. Use them at different times of day.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Ascorbic Acid', 'Benzoyl Peroxide', 'conflict', 'Benzoyl peroxide oxidises vitamin C, leaving both less effective.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Glycolic Acid', 'Salicylic Acid', 'caution', 'Stacking AHA and BHA exfoliants risks over-exfoliation and irritation.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Glycolic Acid', 'Retinol', 'caution', 'An AHA exfoliant with retinol can irritate. Alternate evenings.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Lactic Acid', 'Retinol', 'caution', 'An AHA exfoliant with retinol can irritate. Alternate evenings.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Retinol', 'Salicylic Acid', 'caution', 'A BHA exfoliant with retinol can irritate and dry the skin. Alternate evenings.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Ascorbic Acid', 'Glycolic Acid', 'caution', 'Low-pH vitamin C with an AHA exfoliant can sting sensitive skin.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Hyaluronic Acid', 'Retinol', 'synergy', 'Hyaluronic acid hydrates and offsets the dryness retinol causes.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Niacinamide', 'Retinol', 'synergy', 'Niacinamide supports the skin barrier and eases retinol irritation.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Ceramides', 'Retinol', 'synergy', 'Ceramides repair the barrier that retinol can weaken.');
+INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason)
+VALUES ('Niacinamide', 'Salicylic Acid', 'synergy', 'Niacinamide calms the redness salicylic acid can leave while both help with blemishes.');
+/* vitamin C as the seeded key ingredients and serums spell it, so the rules above match them */
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Vitamin - C', 'Ascorbic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('Ethyl Ascorbic Acid', 'Ascorbic Acid');
+INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES ('3-O-Ethyl Ascorbic Acid', 'Ascorbic Acid');
@@ -0,0 +1,85 @@
+/* what is known about individual ingredients: fragrance and essential oils, drying alcohols, common allergens, a
+   comedogenic rating from 0 to 5 where known, and whether to take care during pregnancy. Names are canonical, as in
+   the parsed ingredient lists, and products earn badges such as fragrance-free from them */
+CREATE TABLE Ingredient_Attribute (Attribute_ID INTEGER PRIMARY KEY AUTOINCREMENT,
+                                   Ingredient nvarchar(100) NOT NULL COLLATE NOCASE,
+                                   Fragrance boolean NOT NULL DEFAULT 0, Essential_Oil boolean NOT NULL DEFAULT 0,
+                                   Drying_Alcohol boolean NOT NULL DEFAULT 0, Allergen boolean NOT NULL DEFAULT 0,
+                                   Comedogenic_Rating int NULL, Pregnancy_Caution boolean NOT NULL DEFAULT 0,
+                                   UNIQUE (Ingredient));
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Fragrance', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Linalool', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Limonene', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Citronellol', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Geraniol', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Eugenol', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Coumarin', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Citral', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Hexyl Cinnamal', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Benzyl Salicylate', 1, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Benzyl Alcohol', 0, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Lavandula Angustifolia (Lavender) Oil', 1, 1, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Melaleuca Alternifolia (Tea Tree) Leaf Oil', 1, 1, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Mentha Piperita (Peppermint) Oil', 1, 1, 0, 0, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Eucalyptus Globulus Leaf Oil', 1, 1, 0, 0, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Alcohol', 0, 0, 1, 0, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Alcohol Denat', 0, 0, 1, 0, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Isopropyl Alcohol', 0, 0, 1, 0, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Methylisothiazolinone', 0, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Methylchloroisothiazolinone', 0, 0, 0, 1, NULL, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Lanolin', 0, 0, 0, 1, 2, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Coconut Oil', 0, 0, 0, 0, 4, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Isopropyl Myristate', 0, 0, 0, 0, 5, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Isopropyl Palmitate', 0, 0, 0, 0, 4, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Myristyl Myristate', 0, 0, 0, 0, 5, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Laureth-4', 0, 0, 0, 0, 5, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Theobroma Cacao (Cocoa) Seed Butter', 0, 0, 0, 0, 4, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Olea Europaea (Olive) Fruit Oil', 0, 0, 0, 0, 2, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Glycerin', 0, 0, 0, 0, 0, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Niacinamide', 0, 0, 0, 0, 0, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Hyaluronic Acid', 0, 0, 0, 0, 0, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Squalane', 0, 0, 0, 0, 1, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Dimethicone', 0, 0, 0, 0, 1, 0);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Retinol', 0, 0, 0, 0, NULL, 1);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Retinyl Palmitate', 0, 0, 0, 0, NULL, 1);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Tretinoin', 0, 0, 0, 0, NULL, 1);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Hydroquinone', 0, 0, 0, 0, NULL, 1);
+INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+VALUES ('Salicylic Acid', 0, 0, 0, 0, NULL, 1);
@@ -0,0 +1 @@
+/* 0015 changed nothing on MySQL */
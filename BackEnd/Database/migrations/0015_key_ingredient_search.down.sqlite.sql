@@ -0,0 +1,24 @@
+DROP TRIGGER Product_Key_Ingredient_Search_Delete;
+DROP TRIGGER Product_Key_Ingredient_Search_Insert;
+DROP TRIGGER Key_Ingredients_Search_Update;
+DROP TRIGGER Products_Search_Update;
+DROP TRIGGER Products_Search_Insert;
+CREATE TRIGGER Products_Search_Insert AFTER INSERT ON Products BEGIN
+    INSERT INTO Product_Search (rowid, Product_Name, All_Ingredients, Brand, Key_Ingredients)
+    VALUES (NEW.Product_ID, NEW.Product_Name, NEW.All_Ingredients,
+            COALESCE((SELECT Brand FROM Brand WHERE Brand_ID = NEW.Brand_ID), ''),
+            COALESCE((SELECT Key_Ingredients FROM Key_Ingredients WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID), ''));
+END;
+CREATE TRIGGER Products_Search_Update AFTER UPDATE ON Products BEGIN
+    DELETE FROM Product_Search WHERE rowid = OLD.Product_ID;
+    INSERT INTO Product_Search (rowid, Product_Name, All_Ingredients, Brand, Key_Ingredients)
+    VALUES (NEW.Product_ID, NEW.Product_Name, NEW.All_Ingredients,
+            COALESCE((SELECT Brand FROM Brand WHERE Brand_ID = NEW.Brand_ID), ''),
+            COALESCE((SELECT Key_Ingredients FROM Key_Ingredients WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID), ''));
+END;
+CREATE TRIGGER Key_Ingredients_Search_Update AFTER UPDATE OF Key_Ingredients ON Key_Ingredients BEGIN
+    UPDATE Product_Search SET Key_Ingredients = NEW.Key_Ingredients
+    WHERE rowid IN (SELECT Product_ID FROM Products WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID);
+END;
+UPDATE Product_Search SET Key_Ingredients = COALESCE((SELECT k.Key_Ingredients FROM Products p
+    JOIN Key_Ingredients k ON p.Key_Ingredients_ID = k.Key_Ingredients_ID WHERE p.Product_ID = Product_Search.rowid), '');
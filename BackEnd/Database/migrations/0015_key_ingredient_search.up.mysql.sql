@@ -0,0 +1,2 @@
+/* MySQL matches the key ingredients linked through Product_Key_Ingredient at query time, using the FULLTEXT index
+   0006 added on Key_Ingredients, so there is nothing to change */
@@ -0,0 +1,45 @@
+/* index every key ingredient a product is linked to, not only its primary one. The primary link is matched as well
+   as Product_Key_Ingredient because products are inserted before their links */
+UPDATE Product_Search SET Key_Ingredients = COALESCE((SELECT group_concat(k.Key_Ingredients, ' ') FROM Key_Ingredients k
+    WHERE k.Key_Ingredients_ID = (SELECT Key_Ingredients_ID FROM Products WHERE Product_ID = Product_Search.rowid)
+       OR k.Key_Ingredients_ID IN (SELECT Key_Ingredients_ID FROM Product_Key_Ingredient WHERE Product_ID = Product_Search.rowid)), '');
+
+DROP TRIGGER Products_Search_Insert;
+DROP TRIGGER Products_Search_Update;
+DROP TRIGGER Key_Ingredients_Search_Update;
+CREATE TRIGGER Products_Search_Insert AFTER INSERT ON Products BEGIN
+    INSERT INTO Product_Search (rowid, Product_Name, All_Ingredients, Brand, Key_Ingredients)
+    VALUES (NEW.Product_ID, NEW.Product_Name, NEW.All_Ingredients,
+            COALESCE((SELECT Brand FROM Brand WHERE Brand_ID = NEW.Brand_ID), ''),
+            COALESCE((SELECT group_concat(Key_Ingredients, ' ') FROM Key_Ingredients
+                      WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID
+                         OR Key_Ingredients_ID IN (SELECT Key_Ingredients_ID FROM Product_Key_Ingredient WHERE Product_ID = NEW.Product_ID)), ''));
+END;
+CREATE TRIGGER Products_Search_Update AFTER UPDATE ON Products BEGIN
+    DELETE FROM Product_Search WHERE rowid = OLD.Product_ID;
+    INSERT INTO Product_Search (rowid, Product_Name, All_Ingredients, Brand, Key_Ingredients)
+    VALUES (NEW.Product_ID, NEW.Product_Name, NEW.All_Ingredients,
+            COALESCE((SELECT Brand FROM Brand WHERE Brand_ID = NEW.Brand_ID), ''),
+            COALESCE((SELECT group_concat(Key_Ingredients, ' ') FROM Key_Ingredients
+                      WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID
+                         OR Key_Ingredients_ID IN (SELECT Key_Ingredients_ID FROM Product_Key_Ingredient WHERE Product_ID = NEW.Product_ID)), ''));
+END;
+CREATE TRIGGER Key_Ingredients_Search_Update AFTER UPDATE OF Key_Ingredients ON Key_Ingredients BEGIN
+    UPDATE Product_Search SET Key_Ingredients = COALESCE((SELECT group_concat(k.Key_Ingredients, ' ') FROM Key_Ingredients k
+        WHERE k.Key_Ingredients_ID = (SELECT Key_Ingredients_ID FROM Products WHERE Product_ID = Product_Search.rowid)
+           OR k.Key_Ingredients_ID IN (SELECT Key_Ingredients_ID FROM Product_Key_Ingredient WHERE Product_ID = Product_Search.rowid)), '')
+    WHERE rowid IN (SELECT Product_ID FROM Products WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID
+                    UNION SELECT Product_ID FROM Product_Key_Ingredient WHERE Key_Ingredients_ID = NEW.Key_Ingredients_ID);
+END;
+CREATE TRIGGER Product_Key_Ingredient_Search_Insert AFTER INSERT ON Product_Key_Ingredient BEGIN
+    UPDATE Product_Search SET Key_Ingredients = COALESCE((SELECT group_concat(k.Key_Ingredients, ' ') FROM Key_Ingredients k
+        WHERE k.Key_Ingredients_ID = (SELECT Key_Ingredients_ID FROM Products WHERE Product_ID = NEW.Product_ID)
+           OR k.Key_Ingredients_ID IN (SELECT Key_Ingredients_ID FROM Product_Key_Ingredient WHERE Product_ID = NEW.Product_ID)), '')
+    WHERE rowid = NEW.Product_ID;
+END;
+CREATE TRIGGER Product_Key_Ingredient_Search_Delete AFTER DELETE ON Product_Key_Ingredient BEGIN
+    UPDATE Product_Search SET Key_Ingredients = COALESCE((SELECT group_concat(k.Key_Ingredients, ' ') FROM Key_Ingredients k
+        WHERE k.Key_Ingredients_ID = (SELECT Key_Ingredients_ID FROM Products WHERE Product_ID = OLD.Product_ID)
+           OR k.Key_Ingredients_ID IN (SELECT Key_Ingredients_ID FROM Product_Key_Ingredient WHERE Product_ID = OLD.Product_ID)), '')
+    WHERE rowid = OLD.Product_ID;
+END;
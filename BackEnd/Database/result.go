@@ -0,0 +1,33 @@
+package database
+
+import (
+	"database/sql"
+)
+
+// RequireRow reports sql.ErrNoRows when an UPDATE or DELETE matched no row.
+// MySQL connections are opened with clientFoundRows, so an UPDATE that leaves
+// a row unchanged still counts it.
+func RequireRow(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// InTx runs fn in a transaction, committing if it returns nil and rolling
+// back otherwise.
+func InTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
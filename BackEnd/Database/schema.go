@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Expectation names a table and every column the code reads or writes in it.
+type Expectation struct {
+	Table   string
+	Columns []string
+}
+
+// TableDrift describes how one table differs from its Expectation.
+type TableDrift struct {
+	Table      string
+	Absent     bool
+	Missing    []string
+	Unexpected []string
+}
+
+// DriftError reports every table whose live columns differ from the code.
+type DriftError struct {
+	Tables []TableDrift
+}
+
+func (e *DriftError) Error() string {
+	var report strings.Builder
+	report.WriteString("database schema does not match the code:")
+	for _, drift := range e.Tables {
+		if drift.Absent {
+			fmt.Fprintf(&report, "\n  %s: table does not exist", drift.Table)
+			continue
+		}
+		if len(drift.Missing) > 0 {
+			fmt.Fprintf(&report, "\n  %s: missing column(s) %s", drift.Table, strings.Join(drift.Missing, ", "))
+		}
+		if len(drift.Unexpected) > 0 {
+			fmt.Fprintf(&report, "\n  %s: unexpected column(s) %s", drift.Table, strings.Join(drift.Unexpected, ", "))
+		}
+	}
+	return report.String()
+}
+
+// CheckSchema compares the live columns of each expected table, in any order
+// and ignoring case, against the columns the code uses. Any difference is
+// returned as a *DriftError listing every affected table.
+func CheckSchema(db *sql.DB, dialect Dialect, expected []Expectation) error {
+	ctx := context.Background()
+	drift := &DriftError{}
+	for _, expectation := range expected {
+		exists, err := tableExists(ctx, db, dialect, expectation.Table)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			drift.Tables = append(drift.Tables, TableDrift{Table: expectation.Table, Absent: true})
+			continue
+		}
+		live, err := Columns(ctx, db, dialect, expectation.Table)
+		if err != nil {
+			return err
+		}
+		tableDrift := TableDrift{Table: expectation.Table}
+		for _, column := range expectation.Columns {
+			if !containsFold(live, column) {
+				tableDrift.Missing = append(tableDrift.Missing, column)
+			}
+		}
+		for _, column := range live {
+			if !containsFold(expectation.Columns, column) {
+				tableDrift.Unexpected = append(tableDrift.Unexpected, column)
+			}
+		}
+		if len(tableDrift.Missing) > 0 || len(tableDrift.Unexpected) > 0 {
+			drift.Tables = append(drift.Tables, tableDrift)
+		}
+	}
+	if len(drift.Tables) > 0 {
+		return drift
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"strings"
+)
+
+// SplitStatements breaks a SQL script into its individual statements. Semicolons
+// inside quoted strings, comments and the BEGIN ... END body of a CREATE
+// TRIGGER do not end a statement, and comments are dropped from the result.
+func SplitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	flush := func() {
+		if statement := strings.TrimSpace(current.String()); statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+	for i := 0; i < len(script); i++ {
+		ch := script[i]
+		switch {
+		case ch == '\'' || ch == '"' || ch == '`':
+			// Copy the quoted string through to its closing quote; a doubled
+			// quote is an escaped quote rather than the end of the string.
+			current.WriteByte(ch)
+			for i++; i < len(script); i++ {
+				current.WriteByte(script[i])
+				if script[i] == '\\' && ch != '`' && i+1 < len(script) {
+					i++
+					current.WriteByte(script[i])
+				} else if script[i] == ch {
+					if i+1 < len(script) && script[i+1] == ch {
+						i++
+						current.WriteByte(script[i])
+						continue
+					}
+					break
+				}
+			}
+		case ch == '-' && strings.HasPrefix(script[i:], "--"), ch == '#':
+			for i < len(script) && script[i] != '\n' {
+				i++
+			}
+			current.WriteByte('\n')
+		case ch == '/' && strings.HasPrefix(script[i:], "/*"):
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				i = len(script)
+			} else {
+				i += end + 3
+			}
+			current.WriteByte(' ')
+		case ch == ';' && inTriggerBody(current.String()):
+			current.WriteByte(ch)
+		case ch == ';':
+			flush()
+		default:
+			current.WriteByte(ch)
+		}
+	}
+	flush()
+	return statements
+}
+
+// inTriggerBody reports whether statement is a CREATE TRIGGER whose body has
+// been opened but not yet closed by END.
+func inTriggerBody(statement string) bool {
+	words := strings.Fields(strings.ToUpper(statement))
+	trigger := false
+	for i := 1; i < len(words) && i < 4; i++ {
+		if words[i] == "TRIGGER" {
+			trigger = words[0] == "CREATE"
+		}
+	}
+	if !trigger {
+		return false
+	}
+	for _, word := range words {
+		if word == "BEGIN" {
+			return words[len(words)-1] != "END"
+		}
+	}
+	return false
+}
+
+// abbreviate shortens a statement for use in error messages; seed INSERTs
+// carry whole ingredient lists.
+func abbreviate(statement string) string {
+	statement = strings.Join(strings.Fields(statement), " ")
+	if len(statement) > 80 {
+		return statement[:77] + "..."
+	}
+	return statement
+}
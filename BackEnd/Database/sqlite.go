@@ -0,0 +1,22 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+// OpenSQLite opens the SQLite database file at path, creating it if needed.
+// The schema and seed data come from the migrations applied by Migrate.
+func OpenSQLite(path string) (*sql.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", path)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows a single writer; serialising access through one
+	// connection avoids "database is locked" errors under concurrent requests.
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
@@ -0,0 +1,142 @@
+package idempotency
+
+import (
+	"BackEnd/Api_Error"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log"
+	"net/http"
+)
+
+// Header is the request header carrying the client's idempotency key.
+const Header = "Idempotency-Key"
+
+// ReplayedHeader marks a response that was replayed from an earlier request.
+const ReplayedHeader = "Idempotent-Replayed"
+
+// Longest key the Idempotency_Key column holds
+const maxKeyLength = 255
+
+// Middleware makes requests that carry an Idempotency-Key safe to retry. The
+// first successful response for a key is stored and sent again, unchanged,
+// for any later request with the same key, method, path and body. Requests
+// without the header are handled as usual.
+func Middleware(store Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(Header)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxKeyLength {
+			api_error.Respond(c, api_error.BadRequest("Invalid "+Header, api_error.FieldError{
+				Field:   Header,
+				Code:    api_error.TooLong,
+				Message: fmt.Sprintf("%s must be at most %d characters", Header, maxKeyLength),
+			}))
+			return
+		}
+		requestHash, err := hashRequest(c)
+		if err != nil {
+			api_error.Respond(c, api_error.BadRequest("Invalid request body: "+err.Error()))
+			return
+		}
+
+		record, reserved, err := store.Reserve(key, requestHash)
+		if err != nil {
+			api_error.Respond(c, err)
+			return
+		}
+		if !reserved {
+			replay(c, record, requestHash)
+			return
+		}
+
+		// Handle the request, keeping a copy of what is sent
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		defer func() {
+			// A handler that panics must not leave the key held until it
+			// expires; free it and let Recovery answer
+			if recovered := recover(); recovered != nil {
+				if err := store.Release(key); err != nil {
+					log.Printf("Releasing %s %q failed [request_id=%s]: %v", Header, key, api_error.RequestID(c), err)
+				}
+				panic(recovered)
+			}
+		}()
+		c.Next()
+
+		// Only successes are kept; a failed request may be retried with the same key
+		if status := writer.Status(); status >= 200 && status < 300 {
+			record.Status = status
+			record.Location = writer.Header().Get("Location")
+			record.Body = writer.body.Bytes()
+			err = store.Complete(record)
+		} else {
+			err = store.Release(key)
+		}
+		if err != nil {
+			log.Printf("Saving %s %q failed [request_id=%s]: %v", Header, key, api_error.RequestID(c), err)
+		}
+	}
+}
+
+// replay answers a request whose key is already held.
+func replay(c *gin.Context, record Record, requestHash string) {
+	switch {
+	case record.RequestHash != requestHash:
+		api_error.Respond(c, &api_error.Error{
+			Status:  http.StatusUnprocessableEntity,
+			Code:    api_error.CodeInvalidRequest,
+			Message: Header + " was already used for a different request",
+		})
+	case record.Status == 0:
+		api_error.Respond(c, api_error.Conflict("A request with this "+Header+" is still being handled"))
+	default:
+		if record.Location != "" {
+			c.Header("Location", record.Location)
+		}
+		c.Header(ReplayedHeader, "true")
+		c.Data(record.Status, gin.MIMEJSON+"; charset=utf-8", record.Body)
+		c.Abort()
+	}
+}
+
+// hashRequest fingerprints the method, path, query and body, then restores
+// the body for the handler.
+func hashRequest(c *gin.Context) (string, error) {
+	var body []byte
+	if c.Request.Body != nil {
+		var err error
+		body, err = io.ReadAll(c.Request.Body)
+		if err != nil {
+			return "", err
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	hash := sha256.New()
+	io.WriteString(hash, c.Request.Method+" "+c.Request.URL.RequestURI()+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// recordingWriter passes a response through while keeping a copy of its body.
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
@@ -0,0 +1,89 @@
+package idempotency
+
+import (
+	"BackEnd/Api_Error"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestRouter(store Store, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(api_error.Recovery())
+	router.POST("/things/create", Middleware(store), handler)
+	return router
+}
+
+func post(router http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/things/create", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(Header, key)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestMiddlewareReplaysSuccess(t *testing.T) {
+	calls := 0
+	router := newTestRouter(NewMemoryStore(), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+	first := post(router, "key-1", `{"a":1}`)
+	second := post(router, "key-1", `{"a":1}`)
+	if first.Code != http.StatusCreated || second.Code != http.StatusCreated {
+		t.Fatalf("statuses = %d, %d; want 201, 201", first.Code, second.Code)
+	}
+	if calls != 1 {
+		t.Errorf("handler ran %d times; want 1", calls)
+	}
+	if second.Header().Get(ReplayedHeader) != "true" || second.Body.String() != first.Body.String() {
+		t.Errorf("second response = %q %q; want a replay of %q", second.Header().Get(ReplayedHeader), second.Body.String(), first.Body.String())
+	}
+}
+
+func TestMiddlewareRejectsDifferentBody(t *testing.T) {
+	router := newTestRouter(NewMemoryStore(), func(c *gin.Context) { c.JSON(http.StatusCreated, gin.H{}) })
+	post(router, "key-1", `{"a":1}`)
+	if w := post(router, "key-1", `{"a":2}`); w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d; want 422", w.Code)
+	}
+}
+
+func TestMiddlewareReleasesKeyAfterFailure(t *testing.T) {
+	fail := true
+	router := newTestRouter(NewMemoryStore(), func(c *gin.Context) {
+		if fail {
+			c.JSON(http.StatusBadRequest, gin.H{})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+	post(router, "key-1", `{}`)
+	fail = false
+	if w := post(router, "key-1", `{}`); w.Code != http.StatusCreated {
+		t.Errorf("retry status = %d; want 201", w.Code)
+	}
+}
+
+func TestMiddlewareReleasesKeyAfterPanic(t *testing.T) {
+	fail := true
+	router := newTestRouter(NewMemoryStore(), func(c *gin.Context) {
+		if fail {
+			panic("handler failed")
+		}
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+	if w := post(router, "key-1", `{}`); w.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d; want 500", w.Code)
+	}
+	fail = false
+	if w := post(router, "key-1", `{}`); w.Code != http.StatusCreated {
+		t.Errorf("retry status = %d; want 201, not left held by the panic", w.Code)
+	}
+}
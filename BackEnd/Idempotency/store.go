@@ -0,0 +1,129 @@
+package idempotency
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+)
+
+// Record is what is kept for one Idempotency-Key. Status is 0 while the
+// request that claimed the key is still being handled.
+type Record struct {
+	Key         string
+	RequestHash string
+	Status      int
+	Location    string
+	Body        []byte
+}
+
+// Store remembers the outcome of requests sent with an Idempotency-Key.
+// Keys are forgotten once they are older than ttl.
+type Store interface {
+	// Reserve claims key for a new request. If the key is already held it
+	// returns the existing record and false instead.
+	Reserve(key, requestHash string) (Record, bool, error)
+	// Complete stores the response to replay for a reserved key.
+	Complete(record Record) error
+	// Release gives up a reserved key so the request can be retried.
+	Release(key string) error
+}
+
+// How long a key is honoured after it was first used
+const ttl = 24 * time.Hour
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Idempotency_Key"
+
+var Columns = []string{"Idempotency_Key", "Request_Hash", "Status_Code", "Location", "Response_Body", "Created_At"}
+
+// SQLStore keeps idempotency records in the Idempotency_Key table, so that
+// a retry is recognised whichever instance it reaches.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) Reserve(key, requestHash string) (Record, bool, error) {
+	now := time.Now()
+	if _, err := s.db.Exec("DELETE FROM Idempotency_Key WHERE Created_At < ?", now.Add(-ttl).Unix()); err != nil {
+		return Record{}, false, err
+	}
+	_, err := s.db.Exec("INSERT INTO Idempotency_Key (Idempotency_Key, Request_Hash, Created_At) VALUES (?, ?, ?)",
+		key, requestHash, now.Unix())
+	if err == nil {
+		return Record{Key: key, RequestHash: requestHash}, true, nil
+	}
+	// The insert fails when the key is taken; anything else is reported as is
+	var record Record
+	var body sql.NullString
+	lookupErr := s.db.QueryRow("SELECT Idempotency_Key, Request_Hash, Status_Code, Location, Response_Body FROM Idempotency_Key WHERE Idempotency_Key = ?", key).
+		Scan(&record.Key, &record.RequestHash, &record.Status, &record.Location, &body)
+	if lookupErr != nil {
+		return Record{}, false, err
+	}
+	record.Body = []byte(body.String)
+	return record, false, nil
+}
+
+func (s *SQLStore) Complete(record Record) error {
+	_, err := s.db.Exec("UPDATE Idempotency_Key SET Status_Code = ?, Location = ?, Response_Body = ? WHERE Idempotency_Key = ?",
+		record.Status, record.Location, string(record.Body), record.Key)
+	return err
+}
+
+func (s *SQLStore) Release(key string) error {
+	_, err := s.db.Exec("DELETE FROM Idempotency_Key WHERE Idempotency_Key = ?", key)
+	return err
+}
+
+// MemoryStore keeps idempotency records in process memory. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+	created map[string]time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]Record), created: make(map[string]time.Time)}
+}
+
+func (s *MemoryStore) Reserve(key, requestHash string) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for held, created := range s.created {
+		if now.Sub(created) > ttl {
+			delete(s.records, held)
+			delete(s.created, held)
+		}
+	}
+	if record, ok := s.records[key]; ok {
+		return record, false, nil
+	}
+	record := Record{Key: key, RequestHash: requestHash}
+	s.records[key] = record
+	s.created[key] = now
+	return record, true, nil
+}
+
+func (s *MemoryStore) Complete(record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.records[record.Key]; ok {
+		s.records[record.Key] = record
+	}
+	return nil
+}
+
+func (s *MemoryStore) Release(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	delete(s.created, key)
+	return nil
+}
@@ -0,0 +1,262 @@
+package inci
+
+import (
+	"BackEnd/Ingredient_Synonym"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxNameLength is the width of the columns parsed names are stored in.
+// Longer names are cut to fit.
+const MaxNameLength = 255
+
+// Entry is one ingredient of a parsed list, in the order it was listed.
+type Entry struct {
+	Position int
+	// Name is the ingredient as listed, tidied and without its percentage.
+	// Names listed all in capitals or all in lower case are title-cased.
+	Name string
+	// Alternatives are the parts of a name such as "Aqua/Water/Eau" that
+	// gives one ingredient several names. Slashes also appear inside single
+	// names, as in "Caprylic/Capric Triglyceride", so callers decide which
+	// reading applies.
+	Alternatives []string
+	// Concentration is the listed percentage, or nil if none was given.
+	Concentration *float64
+	// MayContain marks ingredients after a "may contain" or "+/-" marker,
+	// which are only present in some batches or shades.
+	MayContain bool
+}
+
+// percentage matches a concentration such as "4%", "6.3 %" or "0,5%".
+var percentage = regexp.MustCompile(`(\d+(?:[.,]\d+)?)\s*%`)
+
+// mayContainMarkers open the part of a list that varies between batches.
+var mayContainMarkers = []string{"may contain", "+/-", "±"}
+
+// Parse splits an INCI ingredient list into its entries. Ingredients are
+// separated by commas, semicolons and full stops; separators inside
+// parentheses or brackets and commas between digits, as in
+// "1,2-Hexanediol", do not split. Labels such as "Active Ingredients:" and
+// batch codes such as "FIL.1743.V00" are dropped.
+func Parse(list string) []Entry {
+	var entries []Entry
+	mayContain := false
+	for _, token := range split(list) {
+		if before, rest, bracketed, ok := splitMarker(token); ok {
+			entries = appendEntry(entries, before, mayContain)
+			// A bracketed group closes the may-contain part; otherwise it
+			// runs to the end of the list
+			mayContain = mayContain || !bracketed
+			for _, part := range split(rest) {
+				entries = appendEntry(entries, part, true)
+			}
+			continue
+		}
+		entries = appendEntry(entries, token, mayContain)
+	}
+	return entries
+}
+
+// appendEntry adds token to entries unless nothing is left of it once
+// tidied.
+func appendEntry(entries []Entry, token string, mayContain bool) []Entry {
+	token = stripLabel(token)
+	if isBatchCode(token) {
+		return entries
+	}
+	var concentration *float64
+	if match := percentage.FindStringSubmatchIndex(token); match != nil {
+		value, err := strconv.ParseFloat(strings.Replace(token[match[2]:match[3]], ",", ".", 1), 64)
+		if err == nil {
+			concentration = &value
+		}
+		token = token[:match[0]] + token[match[1]:]
+		token = strings.NewReplacer("()", "", "[]", "", "( )", "", "[ ]", "").Replace(token)
+	}
+	name := truncate(canonicalCase(ingredient_synonym.Clean(token)))
+	if name == "" {
+		return entries
+	}
+	entry := Entry{
+		Position:      len(entries) + 1,
+		Name:          name,
+		Concentration: concentration,
+		MayContain:    mayContain,
+	}
+	if parts := splitDepth(name, func(r rune) bool { return r == '/' }); len(parts) > 1 {
+		for _, part := range parts {
+			if part = ingredient_synonym.Clean(part); part != "" {
+				entry.Alternatives = append(entry.Alternatives, part)
+			}
+		}
+	}
+	return append(entries, entry)
+}
+
+// split breaks a list into ingredient tokens.
+func split(list string) []string {
+	runes := []rune(list)
+	return splitDepth(list, func(r rune) bool { return r == ';' }, func(i int) bool {
+		switch runes[i] {
+		case ',':
+			// 1,2-Hexanediol
+			return !(i > 0 && i+1 < len(runes) && unicode.IsDigit(runes[i-1]) && unicode.IsDigit(runes[i+1]))
+		case '.':
+			// A full stop ends a sentence; one inside "6.3%" or "FIL.1743" does not
+			return i+1 == len(runes) || unicode.IsSpace(runes[i+1])
+		}
+		return false
+	})
+}
+
+// splitDepth splits s at runes outside parentheses and brackets for which
+// isSeparator, or any of the positional checks, reports true.
+func splitDepth(s string, isSeparator func(rune) bool, atIndex ...func(int) bool) []string {
+	var parts []string
+	var current []rune
+	depth := 0
+	for i, r := range []rune(s) {
+		switch r {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth > 0 {
+				depth--
+			}
+		}
+		separator := depth == 0 && isSeparator(r)
+		for _, check := range atIndex {
+			separator = separator || depth == 0 && check(i)
+		}
+		if separator {
+			parts = append(parts, strings.TrimSpace(string(current)))
+			current = current[:0]
+			continue
+		}
+		current = append(current, r)
+	}
+	parts = append(parts, strings.TrimSpace(string(current)))
+
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return nonEmpty
+}
+
+// splitMarker finds the may-contain marker in token, as in "May Contain:
+// CI 77891" or "Mica [+/- CI 77491, CI 77492]", and returns the text before
+// it, the ingredients after it and whether they were in brackets.
+func splitMarker(token string) (before, rest string, bracketed, ok bool) {
+	lower := strings.ToLower(token)
+	for _, marker := range mayContainMarkers {
+		at := strings.Index(lower, marker)
+		if at < 0 {
+			continue
+		}
+		before = strings.TrimSpace(token[:at])
+		rest = strings.TrimSpace(token[at+len(marker):])
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, ":"))
+		if strings.HasSuffix(before, "[") || strings.HasSuffix(before, "(") {
+			// The marker opens a bracketed group
+			before = strings.TrimSpace(before[:len(before)-1])
+			rest = strings.TrimRight(rest, "])")
+			bracketed = true
+		}
+		return before, rest, bracketed, true
+	}
+	return "", "", false, false
+}
+
+// stripLabel drops a leading label such as "Ingredients:" or "Inactive
+// Ingredients:".
+func stripLabel(token string) string {
+	colon := strings.Index(token, ":")
+	if colon < 0 {
+		return token
+	}
+	label := strings.ToLower(strings.TrimSpace(token[:colon]))
+	if strings.HasSuffix(label, "ingredients") || strings.HasSuffix(label, "ingredient") || label == "active" || label == "inactive" {
+		return token[colon+1:]
+	}
+	return token
+}
+
+// isBatchCode reports whether token is a lot or formula code such as
+// "FIL.1743.V00" rather than an ingredient: a single word with a full stop
+// inside it that is not part of a number.
+func isBatchCode(token string) bool {
+	token = strings.TrimSpace(token)
+	if strings.ContainsAny(token, " \t") {
+		return false
+	}
+	runes := []rune(token)
+	for i := 1; i+1 < len(runes); i++ {
+		if runes[i] == '.' && !(unicode.IsDigit(runes[i-1]) && unicode.IsDigit(runes[i+1])) {
+			return true
+		}
+	}
+	return false
+}
+
+// word matches the runs of letters and digits that canonicalCase recases.
+var word = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// canonicalCase title-cases a name listed all in capitals or all in lower
+// case, as in "BENZOYL PEROXIDE" or "water", so that one ingredient reads the
+// same whichever list it came from. Names that already mix cases are kept,
+// as are words with digits, such as "C14", and, in capitals, abbreviations
+// such as the CI of "CI 77891" or the PEG of "PEG-100".
+func canonicalCase(name string) string {
+	upper, lower := name == strings.ToUpper(name), name == strings.ToLower(name)
+	if upper == lower {
+		// Mixed case, or no letters at all
+		return name
+	}
+	recased := []rune(name)
+	for _, span := range word.FindAllStringIndex(name, -1) {
+		runes := []rune(name[span[0]:span[1]])
+		if strings.IndexFunc(string(runes), unicode.IsDigit) >= 0 || upper && isAbbreviation(name, span) {
+			continue
+		}
+		start := utf8.RuneCountInString(name[:span[0]])
+		for i, r := range runes {
+			if i == 0 {
+				recased[start+i] = unicode.ToUpper(r)
+			} else {
+				recased[start+i] = unicode.ToLower(r)
+			}
+		}
+	}
+	return string(recased)
+}
+
+// isAbbreviation reports whether the word of name at span, in a name listed
+// in capitals, is too short to be anything else: two letters, or three
+// followed by a number as in "PEG-100".
+func isAbbreviation(name string, span []int) bool {
+	switch utf8.RuneCountInString(name[span[0]:span[1]]) {
+	case 1:
+		return false
+	case 2:
+		return true
+	case 3:
+		rest := name[span[1]:]
+		return len(rest) > 1 && rest[0] == '-' && rest[1] >= '0' && rest[1] <= '9'
+	}
+	return false
+}
+
+// truncate cuts name to MaxNameLength characters.
+func truncate(name string) string {
+	if runes := []rune(name); len(runes) > MaxNameLength {
+		return strings.TrimSpace(string(runes[:MaxNameLength]))
+	}
+	return name
+}
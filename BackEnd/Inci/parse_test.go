@@ -0,0 +1,91 @@
+package inci
+
+import (
+	"reflect"
+	"testing"
+)
+
+func names(entries []Entry) []string {
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	return names
+}
+
+func TestParseNames(t *testing.T) {
+	tests := []struct {
+		list string
+		want []string
+	}{
+		{"Water, Glycerin, Niacinamide", []string{"Water", "Glycerin", "Niacinamide"}},
+		{"Ingredients: Aqua; Glycerin. Fragrance*", []string{"Aqua", "Glycerin", "Fragrance"}},
+		{"Active Ingredients: Benzoyl Peroxide 4%, Water", []string{"Benzoyl Peroxide", "Water"}},
+		{"Water, 1,2-Hexanediol, Caprylic/Capric Triglyceride", []string{"Water", "1,2-Hexanediol", "Caprylic/Capric Triglyceride"}},
+		{"Water, Glycerin (Vegetable Source, Non-GMO), Mica", []string{"Water", "Glycerin (Vegetable Source, Non-GMO)", "Mica"}},
+		{"Water, Glycerin. FIL.1743.V00", []string{"Water", "Glycerin"}},
+		{"Water,, , Glycerin,", []string{"Water", "Glycerin"}},
+
+		// Names in one case throughout are title-cased; mixed case is kept
+		{"BENZOYL PEROXIDE 2.5%, WATER", []string{"Benzoyl Peroxide", "Water"}},
+		{"water, sodium hyaluronate", []string{"Water", "Sodium Hyaluronate"}},
+		{"CERAMIDE NP, CI 77891, PEG-100 STEARATE, STEARETH-21", []string{"Ceramide NP", "CI 77891", "PEG-100 Stearate", "Steareth-21"}},
+		{"SODIUM C14-16 OLEFIN SULFONATE, BUTYROSPERMUM PARKII (SHEA) BUTTER", []string{"Sodium C14-16 Olefin Sulfonate", "Butyrospermum Parkii (Shea) Butter"}},
+		{"Ceramide NP, pH Adjuster", []string{"Ceramide NP", "pH Adjuster"}},
+	}
+	for _, test := range tests {
+		if got := names(Parse(test.list)); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Parse(%q) names = %q; want %q", test.list, got, test.want)
+		}
+	}
+}
+
+func TestParseConcentrations(t *testing.T) {
+	entries := Parse("Niacinamide 10%, Zinc PCA (1 %), Water, Salicylic Acid 0,5%")
+	want := []float64{10, 1, 0, 0.5}
+	if len(entries) != len(want) {
+		t.Fatalf("Parse returned %d entries; want %d", len(entries), len(want))
+	}
+	for i, entry := range entries {
+		switch {
+		case want[i] == 0 && entry.Concentration != nil:
+			t.Errorf("%s concentration = %v; want none", entry.Name, *entry.Concentration)
+		case want[i] != 0 && (entry.Concentration == nil || *entry.Concentration != want[i]):
+			t.Errorf("%s concentration = %v; want %v", entry.Name, entry.Concentration, want[i])
+		}
+	}
+	if entries[1].Name != "Zinc PCA" {
+		t.Errorf("name = %q; want the percentage and its brackets removed", entries[1].Name)
+	}
+}
+
+func TestParseMayContain(t *testing.T) {
+	tests := []struct {
+		list       string
+		mayContain []bool
+	}{
+		{"Water, Mica. May Contain: CI 77891, CI 77491", []bool{false, false, true, true}},
+		{"Water, Mica [+/- CI 77891, CI 77491], Glycerin", []bool{false, false, true, true, false}},
+		{"Water, Mica, +/- CI 77891", []bool{false, false, true}},
+	}
+	for _, test := range tests {
+		entries := Parse(test.list)
+		var got []bool
+		for _, entry := range entries {
+			got = append(got, entry.MayContain)
+		}
+		if !reflect.DeepEqual(got, test.mayContain) {
+			t.Errorf("Parse(%q) may contain = %v (%q); want %v", test.list, got, names(entries), test.mayContain)
+		}
+	}
+}
+
+func TestParseAlternatives(t *testing.T) {
+	entries := Parse("Aqua/Water/Eau, Glycerin")
+	if want := []string{"Aqua", "Water", "Eau"}; !reflect.DeepEqual(entries[0].Alternatives, want) {
+		t.Errorf("alternatives = %q; want %q", entries[0].Alternatives, want)
+	}
+	if entries[1].Alternatives != nil || entries[1].Position != 2 {
+		t.Errorf("second entry = %+v; want position 2 with no alternatives", entries[1])
+	}
+}
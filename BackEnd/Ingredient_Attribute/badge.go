@@ -0,0 +1,82 @@
+package ingredient_attribute
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Highest comedogenic rating, and the lowest that costs a product the
+// non-comedogenic badge
+const (
+	MaxComedogenic    = 5
+	comedogenicCutoff = 3
+)
+
+// Badge is a claim a product earns when none of its ingredients, including
+// those it only may contain, breaks it. Condition is the same test as SQL
+// over Ingredient_Attribute a, left joined so that its columns are NULL for
+// an ingredient the table does not know, for the product stores to filter on.
+type Badge struct {
+	Name      string
+	Condition string
+	breaks    func(attribute IngredientAttribute) bool
+}
+
+// Badges lists every badge, in the order products show them. The "-free"
+// badges for fragrance, essential oils and drying alcohols only reflect what
+// the attribute table knows, so an ingredient missing from it does not cost
+// a product those. Allergen-free and pregnancy-safe need every ingredient to
+// be in the table, and non-comedogenic every ingredient to have a rating,
+// since an unknown ingredient may well be any of them.
+var Badges = []Badge{
+	{"fragrance-free", "a.Fragrance", func(a IngredientAttribute) bool { return a.Fragrance }},
+	{"essential-oil-free", "a.Essential_Oil", func(a IngredientAttribute) bool { return a.EssentialOil }},
+	{"alcohol-free", "a.Drying_Alcohol", func(a IngredientAttribute) bool { return a.DryingAlcohol }},
+	{"allergen-free", "a.Attribute_ID IS NULL OR a.Allergen", func(a IngredientAttribute) bool {
+		return a.AttributeID == 0 || a.Allergen
+	}},
+	{"non-comedogenic", "a.Comedogenic_Rating IS NULL OR a.Comedogenic_Rating >= " + strconv.Itoa(comedogenicCutoff),
+		func(a IngredientAttribute) bool { return a.Comedogenic == nil || *a.Comedogenic >= comedogenicCutoff }},
+	{"pregnancy-safe", "a.Attribute_ID IS NULL OR a.Pregnancy_Caution", func(a IngredientAttribute) bool {
+		return a.AttributeID == 0 || a.PregnancyCaution
+	}},
+}
+
+// BadgeNames returns the name of every badge, in order.
+func BadgeNames() []string {
+	names := make([]string, len(Badges))
+	for i, badge := range Badges {
+		names[i] = badge.Name
+	}
+	return names
+}
+
+// FindBadge looks a badge up by name, ignoring case.
+func FindBadge(name string) (Badge, bool) {
+	for _, badge := range Badges {
+		if strings.EqualFold(badge.Name, strings.TrimSpace(name)) {
+			return badge, true
+		}
+	}
+	return Badge{}, false
+}
+
+// Earned returns the names of the badges none of attributes breaks, given
+// the attributes of every ingredient a product lists, with the zero
+// IngredientAttribute standing in for those the table does not know.
+func Earned(attributes []IngredientAttribute) []string {
+	earned := []string{}
+	for _, badge := range Badges {
+		broken := false
+		for _, attribute := range attributes {
+			if badge.breaks(attribute) {
+				broken = true
+				break
+			}
+		}
+		if !broken {
+			earned = append(earned, badge.Name)
+		}
+	}
+	return earned
+}
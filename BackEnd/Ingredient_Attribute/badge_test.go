@@ -0,0 +1,37 @@
+package ingredient_attribute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEarned(t *testing.T) {
+	low, high := 1, 4
+	tests := []struct {
+		name       string
+		attributes []IngredientAttribute
+		want       []string
+	}{
+		{"all known and rated", []IngredientAttribute{
+			{AttributeID: 1, Comedogenic: &low},
+			{AttributeID: 2, Comedogenic: &low},
+		}, []string{"fragrance-free", "essential-oil-free", "alcohol-free", "allergen-free", "non-comedogenic", "pregnancy-safe"}},
+		{"an unknown ingredient", []IngredientAttribute{
+			{AttributeID: 1, Comedogenic: &low},
+			{},
+		}, []string{"fragrance-free", "essential-oil-free", "alcohol-free"}},
+		{"an unrated ingredient", []IngredientAttribute{
+			{AttributeID: 1, Comedogenic: &low},
+			{AttributeID: 2},
+		}, []string{"fragrance-free", "essential-oil-free", "alcohol-free", "allergen-free", "pregnancy-safe"}},
+		{"flagged ingredients", []IngredientAttribute{
+			{AttributeID: 1, Fragrance: true, Allergen: true, Comedogenic: &low},
+			{AttributeID: 2, DryingAlcohol: true, Comedogenic: &high, PregnancyCaution: true},
+		}, []string{"essential-oil-free"}},
+	}
+	for _, test := range tests {
+		if got := Earned(test.attributes); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: Earned = %q; want %q", test.name, got, test.want)
+		}
+	}
+}
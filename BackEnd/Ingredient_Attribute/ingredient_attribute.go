@@ -0,0 +1,194 @@
+package ingredient_attribute
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Request"
+	"database/sql"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// IngredientAttribute is what is known about one ingredient. Comedogenic is
+// a rating from 0, never clogs pores, to 5, very likely to, or nil where no
+// rating is known. The name is stored canonically, as the parsed product
+// ingredient lists spell it.
+type IngredientAttribute struct {
+	AttributeID      int    `json:"attribute_id"`
+	Ingredient       string `json:"ingredient"`
+	Fragrance        bool   `json:"fragrance"`
+	EssentialOil     bool   `json:"essential_oil"`
+	DryingAlcohol    bool   `json:"drying_alcohol"`
+	Allergen         bool   `json:"allergen"`
+	Comedogenic      *int   `json:"comedogenic_rating"`
+	PregnancyCaution bool   `json:"pregnancy_caution"`
+}
+
+// Get all ingredient attributes
+func GetAttributes(c *gin.Context, store Store) {
+	attributes, err := store.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, attributes)
+}
+
+// Get an ingredient's attributes by ID
+func GetAttribute(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("attribute_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("attribute_id"))
+		return
+	}
+	attribute, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, attribute)
+}
+
+// Create the attributes of a new ingredient
+func CreateAttribute(c *gin.Context, store Store, normalizer *ingredient_synonym.Normalizer) {
+	newAttribute, err := bindAttribute(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(newAttribute); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	if newAttribute.Ingredient, err = normalizer.Canonical(newAttribute.Ingredient); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Persist the attributes
+	newAttribute, err = store.Create(newAttribute)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/ingredient_attributes/%d", newAttribute.AttributeID))
+	c.JSON(http.StatusCreated, newAttribute)
+}
+
+// Update an ingredient's attributes
+func UpdateAttribute(c *gin.Context, store Store, normalizer *ingredient_synonym.Normalizer) {
+	updatedAttribute, err := bindAttribute(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := ValidateReplacement(updatedAttribute); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	if updatedAttribute.Ingredient, err = normalizer.Canonical(updatedAttribute.Ingredient); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedAttribute)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedAttribute.AttributeID))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of an ingredient's attributes
+func PatchAttribute(c *gin.Context, store Store, normalizer *ingredient_synonym.Normalizer) {
+	id, err := strconv.Atoi(c.Param("attribute_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("attribute_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("attribute_id", patched.AttributeID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	if patched.Ingredient, err = normalizer.Canonical(patched.Ingredient); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Delete an ingredient's attributes
+func DeleteAttribute(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("attribute_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("attribute_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Ingredient attributes deleted"})
+}
+
+// bindAttribute reads an ingredient's attributes from the JSON request body.
+// Like the synonym endpoints, these have no query parameter form.
+func bindAttribute(c *gin.Context) (IngredientAttribute, error) {
+	var attribute IngredientAttribute
+	if !request.HasJSONBody(c) {
+		return attribute, api_error.BadRequest("Request body must be JSON")
+	}
+	err := request.BindJSON(c, &attribute)
+	return attribute, err
+}
+
+// notFound reports an unknown Attribute_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Ingredient attributes %d not found", id))
+}
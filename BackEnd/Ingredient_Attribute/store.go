@@ -0,0 +1,237 @@
+package ingredient_attribute
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Database"
+	"BackEnd/Ingredient_Synonym"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Store is the persistence boundary for ingredient attributes. Lookups, updates and deletes of
+// an unknown Attribute_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned. Each ingredient
+// has at most one row, regardless of case.
+type Store interface {
+	List() ([]IngredientAttribute, error)
+	Get(id int) (IngredientAttribute, error)
+	Create(attribute IngredientAttribute) (IngredientAttribute, error)
+	Update(attribute IngredientAttribute) (IngredientAttribute, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Ingredient_Attribute"
+
+var Columns = []string{"Attribute_ID", "Ingredient", "Fragrance", "Essential_Oil", "Drying_Alcohol", "Allergen",
+	"Comedogenic_Rating", "Pregnancy_Caution"}
+
+// selectColumns is Columns as a SELECT list, in the order scanAttribute reads.
+var selectColumns = strings.Join(Columns, ", ")
+
+// SQLStore keeps attributes in the Ingredient_Attribute table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]IngredientAttribute, error) {
+	rows, err := s.db.Query("SELECT " + selectColumns + " FROM Ingredient_Attribute ORDER BY Attribute_ID")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var attributes []IngredientAttribute
+	for rows.Next() {
+		attribute, err := scanAttribute(rows)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (IngredientAttribute, error) {
+	return scanAttribute(s.db.QueryRow("SELECT "+selectColumns+" FROM Ingredient_Attribute WHERE Attribute_ID = ?", id))
+}
+
+// scanAttribute reads one row selected with selectColumns.
+func scanAttribute(row interface{ Scan(...interface{}) error }) (IngredientAttribute, error) {
+	var attribute IngredientAttribute
+	var comedogenic sql.NullInt64
+	err := row.Scan(&attribute.AttributeID, &attribute.Ingredient, &attribute.Fragrance, &attribute.EssentialOil,
+		&attribute.DryingAlcohol, &attribute.Allergen, &comedogenic, &attribute.PregnancyCaution)
+	if err != nil {
+		return IngredientAttribute{}, err
+	}
+	if comedogenic.Valid {
+		rating := int(comedogenic.Int64)
+		attribute.Comedogenic = &rating
+	}
+	return attribute, nil
+}
+
+func (s *SQLStore) Create(attribute IngredientAttribute) (IngredientAttribute, error) {
+	attribute.Ingredient = strings.TrimSpace(attribute.Ingredient)
+	result, err := s.db.Exec(`
+    INSERT INTO Ingredient_Attribute (Ingredient, Fragrance, Essential_Oil, Drying_Alcohol, Allergen, Comedogenic_Rating, Pregnancy_Caution)
+    VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		attribute.Ingredient, attribute.Fragrance, attribute.EssentialOil, attribute.DryingAlcohol, attribute.Allergen,
+		attribute.Comedogenic, attribute.PregnancyCaution)
+	if err != nil {
+		return IngredientAttribute{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return IngredientAttribute{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(attribute IngredientAttribute) (IngredientAttribute, error) {
+	attribute.Ingredient = strings.TrimSpace(attribute.Ingredient)
+	result, err := s.db.Exec(`
+    UPDATE Ingredient_Attribute SET Ingredient = ?, Fragrance = ?, Essential_Oil = ?, Drying_Alcohol = ?, Allergen = ?,
+        Comedogenic_Rating = ?, Pregnancy_Caution = ?
+    WHERE Attribute_ID = ?`,
+		attribute.Ingredient, attribute.Fragrance, attribute.EssentialOil, attribute.DryingAlcohol, attribute.Allergen,
+		attribute.Comedogenic, attribute.PregnancyCaution, attribute.AttributeID)
+	if err != nil {
+		return IngredientAttribute{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return IngredientAttribute{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(attribute.AttributeID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Ingredient_Attribute WHERE Attribute_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps attributes in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]IngredientAttribute
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]IngredientAttribute)}
+}
+
+func (s *MemoryStore) List() ([]IngredientAttribute, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var attributes []IngredientAttribute
+	for _, attribute := range s.rows {
+		attributes = append(attributes, attribute)
+	}
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].AttributeID < attributes[j].AttributeID })
+	return attributes, nil
+}
+
+func (s *MemoryStore) Get(id int) (IngredientAttribute, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	attribute, ok := s.rows[id]
+	if !ok {
+		return IngredientAttribute{}, sql.ErrNoRows
+	}
+	return attribute, nil
+}
+
+func (s *MemoryStore) Create(attribute IngredientAttribute) (IngredientAttribute, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	attribute.Ingredient = strings.TrimSpace(attribute.Ingredient)
+	if err := s.checkUnique(attribute); err != nil {
+		return IngredientAttribute{}, err
+	}
+	s.lastID++
+	attribute.AttributeID = s.lastID
+	s.rows[attribute.AttributeID] = attribute
+	return attribute, nil
+}
+
+func (s *MemoryStore) Update(attribute IngredientAttribute) (IngredientAttribute, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[attribute.AttributeID]; !ok {
+		return IngredientAttribute{}, sql.ErrNoRows
+	}
+	attribute.Ingredient = strings.TrimSpace(attribute.Ingredient)
+	if err := s.checkUnique(attribute); err != nil {
+		return IngredientAttribute{}, err
+	}
+	s.rows[attribute.AttributeID] = attribute
+	return attribute, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
+
+// checkUnique mirrors the UNIQUE constraint on Ingredient. Callers must hold s.mu.
+func (s *MemoryStore) checkUnique(attribute IngredientAttribute) error {
+	for id, existing := range s.rows {
+		if id != attribute.AttributeID && strings.EqualFold(existing.Ingredient, attribute.Ingredient) {
+			return api_error.Conflict(fmt.Sprintf("Attributes for %q already exist", attribute.Ingredient))
+		}
+	}
+	return nil
+}
+
+// SyncNames renames every row to the canonical name of its ingredient, for
+// rows written before the synonym table knew it, or by a migration. A row
+// whose canonical name another row already holds is left as it is.
+func SyncNames(store Store, normalizer *ingredient_synonym.Normalizer) error {
+	attributes, err := store.List()
+	if err != nil {
+		return err
+	}
+	taken := make(map[string]bool, len(attributes))
+	for _, attribute := range attributes {
+		taken[strings.ToLower(attribute.Ingredient)] = true
+	}
+	for _, attribute := range attributes {
+		canonical, err := normalizer.Canonical(attribute.Ingredient)
+		if err != nil {
+			return err
+		}
+		if canonical == attribute.Ingredient {
+			continue
+		}
+		if !strings.EqualFold(canonical, attribute.Ingredient) && taken[strings.ToLower(canonical)] {
+			log.Printf("Ingredient attributes %d not renamed: %q already has attributes", attribute.AttributeID, canonical)
+			continue
+		}
+		attribute.Ingredient = canonical
+		if _, err := store.Update(attribute); err != nil {
+			return err
+		}
+		taken[strings.ToLower(canonical)] = true
+	}
+	return nil
+}
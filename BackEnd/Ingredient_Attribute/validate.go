@@ -0,0 +1,31 @@
+package ingredient_attribute
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+	"fmt"
+)
+
+// Width of the Ingredient column
+const maxNameLength = 100
+
+// Validate checks an ingredient's attributes before they are written and
+// returns every problem found, or nil. New rows are numbered by the database,
+// so the attribute_id is only checked by ValidateReplacement.
+func Validate(attribute IngredientAttribute) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("ingredient", attribute.Ingredient)
+	v.MaxLength("ingredient", attribute.Ingredient, maxNameLength)
+	if attribute.Comedogenic != nil && (*attribute.Comedogenic < 0 || *attribute.Comedogenic > MaxComedogenic) {
+		v.Add("comedogenic_rating", api_error.Invalid, fmt.Sprintf("comedogenic_rating must be between 0 and %d", MaxComedogenic))
+	}
+	return v.Errors()
+}
+
+// ValidateReplacement checks attributes sent to replace a stored row, which
+// must also name the row's attribute_id.
+func ValidateReplacement(attribute IngredientAttribute) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("attribute_id", attribute.AttributeID)
+	return append(v.Errors(), Validate(attribute)...)
+}
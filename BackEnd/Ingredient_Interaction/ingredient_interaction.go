@@ -0,0 +1,208 @@
+package ingredient_interaction
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
+	"database/sql"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Severities of an interaction, most serious first
+const (
+	Conflict = "conflict" // should not be used together
+	Caution  = "caution"  // may irritate when used together
+	Synergy  = "synergy"  // work well together
+)
+
+var Severities = []string{Conflict, Caution, Synergy}
+
+// IngredientInteraction describes how two ingredients behave in the same
+// routine. The names are matched canonically against products' key
+// ingredients and parsed ingredient lists, in either order.
+type IngredientInteraction struct {
+	InteractionID int    `json:"interaction_id"`
+	IngredientA   string `json:"ingredient_a"`
+	IngredientB   string `json:"ingredient_b"`
+	Severity      string `json:"severity"`
+	Reason        string `json:"reason"`
+}
+
+// Rank orders severities, most serious first.
+func Rank(severity string) int {
+	for i, candidate := range Severities {
+		if candidate == severity {
+			return i
+		}
+	}
+	return len(Severities)
+}
+
+// Get all ingredient interactions
+func GetInteractions(c *gin.Context, store Store) {
+	interactions, err := store.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, interactions)
+}
+
+// Get an ingredient interaction by ID
+func GetInteraction(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("interaction_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("interaction_id"))
+		return
+	}
+	interaction, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, interaction)
+}
+
+// Create a new ingredient interaction
+func CreateInteraction(c *gin.Context, store Store) {
+	newInteraction, err := bindInteraction(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(newInteraction); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the interaction
+	newInteraction, err = store.Create(newInteraction)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/ingredient_interactions/%d", newInteraction.InteractionID))
+	c.JSON(http.StatusCreated, newInteraction)
+}
+
+// Update an ingredient interaction
+func UpdateInteraction(c *gin.Context, store Store) {
+	updatedInteraction, err := bindInteraction(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := ValidateReplacement(updatedInteraction); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedInteraction)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedInteraction.InteractionID))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of an ingredient interaction
+func PatchInteraction(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("interaction_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("interaction_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("interaction_id", patched.InteractionID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Delete an ingredient interaction
+func DeleteInteraction(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("interaction_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("interaction_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Ingredient interaction deleted"})
+}
+
+// bindInteraction reads an interaction from the JSON request body. Like the
+// synonym endpoints, these have no query parameter form.
+func bindInteraction(c *gin.Context) (IngredientInteraction, error) {
+	var interaction IngredientInteraction
+	if !request.HasJSONBody(c) {
+		return interaction, api_error.BadRequest("Request body must be JSON")
+	}
+	err := request.BindJSON(c, &interaction)
+	return interaction, err
+}
+
+// ordered puts the two names of an interaction in alphabetical order, so the
+// UNIQUE constraint also catches a pair sent the other way round.
+func ordered(interaction IngredientInteraction) IngredientInteraction {
+	interaction.IngredientA = strings.TrimSpace(interaction.IngredientA)
+	interaction.IngredientB = strings.TrimSpace(interaction.IngredientB)
+	if strings.ToLower(interaction.IngredientB) < strings.ToLower(interaction.IngredientA) {
+		interaction.IngredientA, interaction.IngredientB = interaction.IngredientB, interaction.IngredientA
+	}
+	return interaction
+}
+
+// notFound reports an unknown Interaction_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Ingredient interaction %d not found", id))
+}
@@ -0,0 +1,187 @@
+package ingredient_interaction
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Database"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Store is the persistence boundary for ingredient interactions. Lookups, updates and deletes of
+// an unknown Interaction_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned. Create and
+// Update store the two names in alphabetical order, and each pair is unique regardless of
+// case.
+type Store interface {
+	List() ([]IngredientInteraction, error)
+	Get(id int) (IngredientInteraction, error)
+	Create(interaction IngredientInteraction) (IngredientInteraction, error)
+	Update(interaction IngredientInteraction) (IngredientInteraction, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Ingredient_Interaction"
+
+var Columns = []string{"Interaction_ID", "Ingredient_A", "Ingredient_B", "Severity", "Reason"}
+
+// SQLStore keeps interactions in the Ingredient_Interaction table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]IngredientInteraction, error) {
+	rows, err := s.db.Query("SELECT Interaction_ID, Ingredient_A, Ingredient_B, Severity, Reason FROM Ingredient_Interaction ORDER BY Interaction_ID")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var interactions []IngredientInteraction
+	for rows.Next() {
+		var interaction IngredientInteraction
+		if err := rows.Scan(&interaction.InteractionID, &interaction.IngredientA, &interaction.IngredientB, &interaction.Severity,
+			&interaction.Reason); err != nil {
+			return nil, err
+		}
+		interactions = append(interactions, interaction)
+	}
+	return interactions, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (IngredientInteraction, error) {
+	var interaction IngredientInteraction
+	err := s.db.QueryRow(`
+    SELECT Interaction_ID, Ingredient_A, Ingredient_B, Severity, Reason
+    FROM Ingredient_Interaction WHERE Interaction_ID = ?`, id).
+		Scan(&interaction.InteractionID, &interaction.IngredientA, &interaction.IngredientB, &interaction.Severity,
+			&interaction.Reason)
+	return interaction, err
+}
+
+func (s *SQLStore) Create(interaction IngredientInteraction) (IngredientInteraction, error) {
+	interaction = ordered(interaction)
+	result, err := s.db.Exec("INSERT INTO Ingredient_Interaction (Ingredient_A, Ingredient_B, Severity, Reason) VALUES (?, ?, ?, ?)",
+		interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Reason)
+	if err != nil {
+		return IngredientInteraction{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return IngredientInteraction{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(interaction IngredientInteraction) (IngredientInteraction, error) {
+	interaction = ordered(interaction)
+	result, err := s.db.Exec(`
+    UPDATE Ingredient_Interaction SET Ingredient_A = ?, Ingredient_B = ?, Severity = ?, Reason = ?
+    WHERE Interaction_ID = ?`,
+		interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Reason, interaction.InteractionID)
+	if err != nil {
+		return IngredientInteraction{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return IngredientInteraction{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(interaction.InteractionID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Ingredient_Interaction WHERE Interaction_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps interactions in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]IngredientInteraction
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]IngredientInteraction)}
+}
+
+func (s *MemoryStore) List() ([]IngredientInteraction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var interactions []IngredientInteraction
+	for _, interaction := range s.rows {
+		interactions = append(interactions, interaction)
+	}
+	sort.Slice(interactions, func(i, j int) bool { return interactions[i].InteractionID < interactions[j].InteractionID })
+	return interactions, nil
+}
+
+func (s *MemoryStore) Get(id int) (IngredientInteraction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	interaction, ok := s.rows[id]
+	if !ok {
+		return IngredientInteraction{}, sql.ErrNoRows
+	}
+	return interaction, nil
+}
+
+func (s *MemoryStore) Create(interaction IngredientInteraction) (IngredientInteraction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	interaction = ordered(interaction)
+	if err := s.checkUnique(interaction); err != nil {
+		return IngredientInteraction{}, err
+	}
+	s.lastID++
+	interaction.InteractionID = s.lastID
+	s.rows[interaction.InteractionID] = interaction
+	return interaction, nil
+}
+
+func (s *MemoryStore) Update(interaction IngredientInteraction) (IngredientInteraction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[interaction.InteractionID]; !ok {
+		return IngredientInteraction{}, sql.ErrNoRows
+	}
+	interaction = ordered(interaction)
+	if err := s.checkUnique(interaction); err != nil {
+		return IngredientInteraction{}, err
+	}
+	s.rows[interaction.InteractionID] = interaction
+	return interaction, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
+
+// checkUnique mirrors the UNIQUE constraint on the pair of names. Callers must hold s.mu.
+func (s *MemoryStore) checkUnique(interaction IngredientInteraction) error {
+	for id, existing := range s.rows {
+		if id != interaction.InteractionID && strings.EqualFold(existing.IngredientA, interaction.IngredientA) &&
+			strings.EqualFold(existing.IngredientB, interaction.IngredientB) {
+			return api_error.Conflict(fmt.Sprintf("An interaction between %q and %q already exists",
+				interaction.IngredientA, interaction.IngredientB))
+		}
+	}
+	return nil
+}
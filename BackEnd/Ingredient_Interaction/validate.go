@@ -0,0 +1,39 @@
+package ingredient_interaction
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+	"strings"
+)
+
+// Widths of the ingredient and Reason columns
+const (
+	maxNameLength   = 100
+	maxReasonLength = 255
+)
+
+// Validate checks an interaction before it is written and returns every
+// problem found, or nil. New rows are numbered by the database, so the
+// interaction_id is only checked by ValidateReplacement.
+func Validate(interaction IngredientInteraction) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("ingredient_a", interaction.IngredientA)
+	v.MaxLength("ingredient_a", interaction.IngredientA, maxNameLength)
+	v.Required("ingredient_b", interaction.IngredientB)
+	v.MaxLength("ingredient_b", interaction.IngredientB, maxNameLength)
+	if strings.EqualFold(strings.TrimSpace(interaction.IngredientA), strings.TrimSpace(interaction.IngredientB)) {
+		v.Add("ingredient_b", api_error.Invalid, "ingredient_b must differ from ingredient_a")
+	}
+	v.OneOf("severity", interaction.Severity, Severities...)
+	v.Required("reason", interaction.Reason)
+	v.MaxLength("reason", interaction.Reason, maxReasonLength)
+	return v.Errors()
+}
+
+// ValidateReplacement checks an interaction sent to replace a stored row,
+// which must also name the row's interaction_id.
+func ValidateReplacement(interaction IngredientInteraction) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("interaction_id", interaction.InteractionID)
+	return append(v.Errors(), Validate(interaction)...)
+}
@@ -0,0 +1,174 @@
+package ingredient_synonym
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
+	"database/sql"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// IngredientSynonym maps an alternative name or spelling of an ingredient to
+// its canonical name.
+type IngredientSynonym struct {
+	SynonymID int    `json:"synonym_id"`
+	Synonym   string `json:"synonym"`
+	Canonical string `json:"canonical"`
+}
+
+// Get all ingredient synonyms
+func GetSynonyms(c *gin.Context, store Store) {
+	synonyms, err := store.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, synonyms)
+}
+
+// Get an ingredient synonym by ID
+func GetSynonym(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("synonym_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("synonym_id"))
+		return
+	}
+	synonym, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, synonym)
+}
+
+// Create a new ingredient synonym
+func CreateSynonym(c *gin.Context, store Store) {
+	newSynonym, err := bindSynonym(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(newSynonym); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the synonym
+	newSynonym, err = store.Create(newSynonym)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/ingredient_synonyms/%d", newSynonym.SynonymID))
+	c.JSON(http.StatusCreated, newSynonym)
+}
+
+// Update an ingredient synonym
+func UpdateSynonym(c *gin.Context, store Store) {
+	updatedSynonym, err := bindSynonym(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := ValidateReplacement(updatedSynonym); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedSynonym)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedSynonym.SynonymID))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of an ingredient synonym
+func PatchSynonym(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("synonym_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("synonym_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("synonym_id", patched.SynonymID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Delete an ingredient synonym
+func DeleteSynonym(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("synonym_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("synonym_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Ingredient synonym deleted"})
+}
+
+// bindSynonym reads a synonym from the JSON request body. This endpoint was
+// added after the query parameter form was deprecated, so it has none.
+func bindSynonym(c *gin.Context) (IngredientSynonym, error) {
+	var synonym IngredientSynonym
+	if !request.HasJSONBody(c) {
+		return synonym, api_error.BadRequest("Request body must be JSON")
+	}
+	err := request.BindJSON(c, &synonym)
+	return synonym, err
+}
+
+// notFound reports an unknown Synonym_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Ingredient synonym %d not found", id))
+}
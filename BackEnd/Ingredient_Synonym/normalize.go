@@ -0,0 +1,285 @@
+package ingredient_synonym
+
+import (
+	"BackEnd/Api_Error"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// How a token was matched by Normalize
+const (
+	MatchCanonical = "canonical" // the token is a canonical name
+	MatchSynonym   = "synonym"   // the token is a listed synonym
+	MatchFuzzy     = "fuzzy"     // the token is a near miss of a known name
+	MatchNone      = "none"      // the token is unknown and is returned cleaned up
+)
+
+// Most names GET /ingredient_synonyms/normalize takes at once
+const maxNormalizeNames = 100
+
+// Normalized is the canonical name found for one free-text token. Distance is
+// the number of edits a fuzzy match needed.
+type Normalized struct {
+	Input    string `json:"input"`
+	Name     string `json:"name"`
+	Match    string `json:"match"`
+	Distance int    `json:"distance,omitempty"`
+}
+
+// Normalizer maps free-text ingredient names onto canonical names using the
+// synonym table, so that search, filters and conflict checks agree on what
+// an ingredient is called. Exact synonyms and canonical names are tried
+// first, then the closest known name within a small edit distance. It is safe
+// for concurrent use.
+type Normalizer struct {
+	store Store
+
+	mu        sync.RWMutex
+	names     map[string]string // lower-cased synonym or canonical name to canonical name
+	canonical map[string]bool   // lower-cased canonical names
+	known     []string          // keys of names, sorted, for the fuzzy search
+	built     bool
+}
+
+func NewNormalizer(store Store) *Normalizer {
+	return &Normalizer{store: store}
+}
+
+// Refresh reloads the synonym table.
+func (n *Normalizer) Refresh() error {
+	synonyms, err := n.store.List()
+	if err != nil {
+		return err
+	}
+	names := make(map[string]string)
+	canonical := make(map[string]bool)
+	for _, synonym := range synonyms {
+		name := strings.TrimSpace(synonym.Canonical)
+		names[fold(synonym.Synonym)] = name
+		names[fold(name)] = name
+		canonical[fold(name)] = true
+	}
+	var known []string
+	for key := range names {
+		known = append(known, key)
+	}
+	sort.Strings(known)
+
+	n.mu.Lock()
+	n.names, n.canonical, n.known, n.built = names, canonical, known, true
+	n.mu.Unlock()
+	return nil
+}
+
+// Normalize finds the canonical name for token. A name in brackets, as in
+// "Aqua (Water)", is tried when the part before it is unknown.
+func (n *Normalizer) Normalize(token string) (Normalized, error) {
+	n.mu.RLock()
+	built := n.built
+	n.mu.RUnlock()
+	if !built {
+		if err := n.Refresh(); err != nil {
+			return Normalized{}, err
+		}
+	}
+
+	cleaned := Clean(token)
+	candidates := []string{cleaned}
+	if open := strings.Index(cleaned, "("); open > 0 && strings.HasSuffix(cleaned, ")") {
+		candidates = []string{strings.TrimSpace(cleaned[:open]), strings.TrimSpace(cleaned[open+1 : len(cleaned)-1])}
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for _, candidate := range candidates {
+		if name, ok := n.names[fold(candidate)]; ok {
+			match := MatchSynonym
+			if n.canonical[fold(candidate)] {
+				match = MatchCanonical
+			}
+			return Normalized{Input: token, Name: name, Match: match}, nil
+		}
+	}
+	for _, candidate := range candidates {
+		if key, distance, ok := n.closest(fold(candidate)); ok {
+			return Normalized{Input: token, Name: n.names[key], Match: MatchFuzzy, Distance: distance}, nil
+		}
+	}
+	return Normalized{Input: token, Name: cleaned, Match: MatchNone}, nil
+}
+
+// Canonical is Normalize for callers that only need the name.
+func (n *Normalizer) Canonical(token string) (string, error) {
+	normalized, err := n.Normalize(token)
+	return normalized.Name, err
+}
+
+// closest finds the known name nearest to key within the edit distance
+// allowed for its length. Names must carry the same numbers, since "CI
+// 77491" and "CI 77891" are different pigments, and the same trailing
+// letter, since "Vitamin D" is not "Vitamin A". Ties go to the
+// alphabetically first name. Callers must hold n.mu.
+func (n *Normalizer) closest(key string) (string, int, bool) {
+	allowed := allowedDistance(key)
+	if allowed == 0 {
+		return "", 0, false
+	}
+	best, bestDistance := "", allowed+1
+	for _, candidate := range n.known {
+		if digits(candidate) != digits(key) || !sameSuffix(candidate, key) {
+			continue
+		}
+		if distance := editDistance(key, candidate, allowed); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best, bestDistance, best != ""
+}
+
+// allowedDistance is about one edit in eight letters. Shorter names differ by
+// a single letter too often to guess: Retinal is not a misspelt Retinol.
+func allowedDistance(key string) int {
+	return len([]rune(key)) / 8
+}
+
+// sameSuffix reports whether a and b may be spellings of one name as far as
+// their last words go. A last word of one letter or of digits alone, as in
+// "Vitamin E" or "Polysorbate 20", names a variant and must match exactly.
+func sameSuffix(a, b string) bool {
+	lastA, lastB := lastWord(a), lastWord(b)
+	if lastA == lastB {
+		return true
+	}
+	return !isVariant(lastA) && !isVariant(lastB)
+}
+
+// lastWord returns the last space-separated word of name.
+func lastWord(name string) string {
+	return name[strings.LastIndex(name, " ")+1:]
+}
+
+// isVariant reports whether word is a single letter or a number.
+func isVariant(word string) bool {
+	return len([]rune(word)) == 1 || word != "" && digits(word) == word
+}
+
+// editDistance is the optimal string alignment distance between a and b:
+// insertions, deletions, substitutions and swaps of adjacent letters each
+// count one. Anything over limit is reported as limit+1.
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > limit || -diff > limit {
+		return limit + 1
+	}
+	rows := make([][]int, len(ra)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(rb)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		rowMin := rows[i][0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d := min3(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] && rows[i-2][j-2]+1 < d {
+				d = rows[i-2][j-2] + 1
+			}
+			rows[i][j] = d
+			if d < rowMin {
+				rowMin = d
+			}
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+	}
+	if d := rows[len(ra)][len(rb)]; d <= limit {
+		return d
+	}
+	return limit + 1
+}
+
+// digits returns the digits of name in order.
+func digits(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// Clean tidies a free-text ingredient name: surrounding spaces, stray
+// punctuation and markers such as a trailing * are dropped and runs of spaces
+// are collapsed.
+func Clean(token string) string {
+	token = strings.Join(strings.Fields(token), " ")
+	return strings.TrimFunc(token, func(r rune) bool {
+		return r != '(' && r != ')' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// fold is the lookup key of a name.
+func fold(name string) string {
+	return strings.ToLower(Clean(name))
+}
+
+// Normalize free-text ingredient names, given as one or more name query
+// parameters
+func NormalizeNames(c *gin.Context, normalizer *Normalizer) {
+	names := c.QueryArray("name")
+	if len(names) == 0 || len(names) > maxNormalizeNames {
+		api_error.Respond(c, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+			Field:   "name",
+			Code:    api_error.Invalid,
+			Message: fmt.Sprintf("Give between 1 and %d name parameters", maxNormalizeNames),
+		}))
+		return
+	}
+	results := make([]Normalized, 0, len(names))
+	for _, name := range names {
+		normalized, err := normalizer.Normalize(name)
+		if err != nil {
+			api_error.Respond(c, err)
+			return
+		}
+		results = append(results, normalized)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": results})
+}
+
+// RefreshAfterWrite reloads the synonyms once a request that changes them has
+// succeeded.
+func (n *Normalizer) RefreshAfterWrite() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if c.Request.Method == http.MethodGet || c.Writer.Status() >= 300 {
+			return
+		}
+		if err := n.Refresh(); err != nil {
+			log.Printf("Reloading ingredient synonyms failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package ingredient_synonym
+
+import "testing"
+
+func newTestNormalizer(t *testing.T) *Normalizer {
+	t.Helper()
+	store := NewMemoryStore()
+	for _, synonym := range []IngredientSynonym{
+		{Synonym: "Aqua", Canonical: "Water"},
+		{Synonym: "Glycerine", Canonical: "Glycerin"},
+		{Synonym: "Vitamin A", Canonical: "Retinol"},
+		{Synonym: "Vitamin C", Canonical: "Ascorbic Acid"},
+		{Synonym: "Vitamin E", Canonical: "Tocopherol"},
+		{Synonym: "Vitamin B3", Canonical: "Niacinamide"},
+		{Synonym: "Hyaluronan", Canonical: "Hyaluronic Acid"},
+		{Synonym: "CI 77891", Canonical: "Titanium Dioxide"},
+	} {
+		if _, err := store.Create(synonym); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewNormalizer(store)
+}
+
+func TestNormalize(t *testing.T) {
+	normalizer := newTestNormalizer(t)
+	tests := []struct {
+		token string
+		name  string
+		match string
+	}{
+		{"Water", "Water", MatchCanonical},
+		{"Aqua", "Water", MatchSynonym},
+		{" aqua* ", "Water", MatchSynonym},
+		{"Aqua (Water)", "Water", MatchSynonym},
+		{"Glycerine", "Glycerin", MatchSynonym},
+		{"Glycerinn", "Glycerin", MatchFuzzy},
+		{"Niacinamid", "Niacinamide", MatchFuzzy},
+		{"Hyaluronic Acd", "Hyaluronic Acid", MatchFuzzy},
+
+		// Near misses that are different ingredients
+		{"Retinal", "Retinal", MatchNone},
+		{"Vitamin D", "Vitamin D", MatchNone},
+		{"Vitamin K", "Vitamin K", MatchNone},
+		{"Vitamin B5", "Vitamin B5", MatchNone},
+		{"CI 77491", "CI 77491", MatchNone},
+		{"Glycerol", "Glycerol", MatchNone},
+	}
+	for _, test := range tests {
+		t.Run(test.token, func(t *testing.T) {
+			got, err := normalizer.Normalize(test.token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Name != test.name || got.Match != test.match {
+				t.Errorf("Normalize(%q) = %q (%s); want %q (%s)", test.token, got.Name, got.Match, test.name, test.match)
+			}
+		})
+	}
+}
+
+func TestClean(t *testing.T) {
+	tests := []struct {
+		token string
+		want  string
+	}{
+		{"  Alcohol   Denat. ", "Alcohol Denat"},
+		{"Fragrance*", "Fragrance"},
+		{"Aqua (Water)", "Aqua (Water)"},
+		{"-Niacinamide-", "Niacinamide"},
+	}
+	for _, test := range tests {
+		if got := Clean(test.token); got != test.want {
+			t.Errorf("Clean(%q) = %q; want %q", test.token, got, test.want)
+		}
+	}
+}
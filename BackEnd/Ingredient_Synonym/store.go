@@ -0,0 +1,173 @@
+package ingredient_synonym
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Database"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Store is the persistence boundary for ingredient synonyms. Lookups, updates and deletes of
+// an unknown Synonym_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned. Synonyms are
+// unique regardless of case.
+type Store interface {
+	List() ([]IngredientSynonym, error)
+	Get(id int) (IngredientSynonym, error)
+	Create(synonym IngredientSynonym) (IngredientSynonym, error)
+	Update(synonym IngredientSynonym) (IngredientSynonym, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Ingredient_Synonym"
+
+var Columns = []string{"Synonym_ID", "Synonym", "Canonical"}
+
+// SQLStore keeps synonyms in the Ingredient_Synonym table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]IngredientSynonym, error) {
+	rows, err := s.db.Query("SELECT Synonym_ID, Synonym, Canonical FROM Ingredient_Synonym ORDER BY Synonym_ID")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var synonyms []IngredientSynonym
+	for rows.Next() {
+		var synonym IngredientSynonym
+		if err := rows.Scan(&synonym.SynonymID, &synonym.Synonym, &synonym.Canonical); err != nil {
+			return nil, err
+		}
+		synonyms = append(synonyms, synonym)
+	}
+	return synonyms, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (IngredientSynonym, error) {
+	var synonym IngredientSynonym
+	err := s.db.QueryRow("SELECT Synonym_ID, Synonym, Canonical FROM Ingredient_Synonym WHERE Synonym_ID = ?", id).
+		Scan(&synonym.SynonymID, &synonym.Synonym, &synonym.Canonical)
+	return synonym, err
+}
+
+func (s *SQLStore) Create(synonym IngredientSynonym) (IngredientSynonym, error) {
+	result, err := s.db.Exec("INSERT INTO Ingredient_Synonym (Synonym, Canonical) VALUES (?, ?)", synonym.Synonym, synonym.Canonical)
+	if err != nil {
+		return IngredientSynonym{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return IngredientSynonym{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(synonym IngredientSynonym) (IngredientSynonym, error) {
+	result, err := s.db.Exec("UPDATE Ingredient_Synonym SET Synonym = ?, Canonical = ? WHERE Synonym_ID = ?",
+		synonym.Synonym, synonym.Canonical, synonym.SynonymID)
+	if err != nil {
+		return IngredientSynonym{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return IngredientSynonym{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(synonym.SynonymID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Ingredient_Synonym WHERE Synonym_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps synonyms in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]IngredientSynonym
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]IngredientSynonym)}
+}
+
+func (s *MemoryStore) List() ([]IngredientSynonym, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var synonyms []IngredientSynonym
+	for _, synonym := range s.rows {
+		synonyms = append(synonyms, synonym)
+	}
+	sort.Slice(synonyms, func(i, j int) bool { return synonyms[i].SynonymID < synonyms[j].SynonymID })
+	return synonyms, nil
+}
+
+func (s *MemoryStore) Get(id int) (IngredientSynonym, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	synonym, ok := s.rows[id]
+	if !ok {
+		return IngredientSynonym{}, sql.ErrNoRows
+	}
+	return synonym, nil
+}
+
+func (s *MemoryStore) Create(synonym IngredientSynonym) (IngredientSynonym, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkUnique(synonym); err != nil {
+		return IngredientSynonym{}, err
+	}
+	s.lastID++
+	synonym.SynonymID = s.lastID
+	s.rows[synonym.SynonymID] = synonym
+	return synonym, nil
+}
+
+func (s *MemoryStore) Update(synonym IngredientSynonym) (IngredientSynonym, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[synonym.SynonymID]; !ok {
+		return IngredientSynonym{}, sql.ErrNoRows
+	}
+	if err := s.checkUnique(synonym); err != nil {
+		return IngredientSynonym{}, err
+	}
+	s.rows[synonym.SynonymID] = synonym
+	return synonym, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
+
+// checkUnique mirrors the UNIQUE constraint on Synonym. Callers must hold s.mu.
+func (s *MemoryStore) checkUnique(synonym IngredientSynonym) error {
+	for id, existing := range s.rows {
+		if id != synonym.SynonymID && strings.EqualFold(existing.Synonym, synonym.Synonym) {
+			return api_error.Conflict(fmt.Sprintf("Synonym %q already exists", synonym.Synonym))
+		}
+	}
+	return nil
+}
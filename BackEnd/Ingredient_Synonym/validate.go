@@ -0,0 +1,33 @@
+package ingredient_synonym
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+	"strings"
+)
+
+// Width of the Synonym and Canonical columns
+const maxNameLength = 100
+
+// Validate checks a synonym before it is written and returns every problem
+// found, or nil. New rows are numbered by the database, so the synonym_id
+// is only checked by ValidateReplacement.
+func Validate(synonym IngredientSynonym) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("synonym", synonym.Synonym)
+	v.MaxLength("synonym", synonym.Synonym, maxNameLength)
+	v.Required("canonical", synonym.Canonical)
+	v.MaxLength("canonical", synonym.Canonical, maxNameLength)
+	if strings.EqualFold(strings.TrimSpace(synonym.Synonym), strings.TrimSpace(synonym.Canonical)) {
+		v.Add("synonym", api_error.Invalid, "synonym must differ from canonical")
+	}
+	return v.Errors()
+}
+
+// ValidateReplacement checks a synonym sent to replace a stored row,
+// which must also name the row's synonym_id.
+func ValidateReplacement(synonym IngredientSynonym) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("synonym_id", synonym.SynonymID)
+	return append(v.Errors(), Validate(synonym)...)
+}
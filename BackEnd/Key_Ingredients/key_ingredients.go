@@ -1,102 +1,181 @@
 package key_ingredients
 
 import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
 	"database/sql"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
 )
 
+// KeyIngredients is an active ingredient products are grouped by. TimeOfDay
+// keeps products with it to the "AM" or "PM" routine, as retinol is kept to
+// the evening, or is empty for both.
 type KeyIngredients struct {
 	KeyIngredientsID int    `json:"key_ingredients_id"`
 	KeyIngredient    string `json:"ingredient"`
+	TimeOfDay        string `json:"time_of_day"`
 }
 
 // Get all key ingredients
-func GetKeyIngredients(c *gin.Context, db *sql.DB) {
-	rows, err := db.Query("SELECT Key_Ingredients_ID, Key_Ingredients FROM Key_Ingredients")
+func GetKeyIngredients(c *gin.Context, store Store) {
+	keyIngredients, err := store.List()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	defer rows.Close()
+	c.JSON(http.StatusOK, keyIngredients)
+}
 
-	var keyIngredients []KeyIngredients
-	for rows.Next() {
-		var ingredient KeyIngredients
-		if err := rows.Scan(&ingredient.KeyIngredientsID, &ingredient.KeyIngredient); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		keyIngredients = append(keyIngredients, ingredient)
+// Get a key ingredient by ID
+func GetKeyIngredient(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("key_ingredients_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("key_ingredients_id"))
+		return
 	}
-
-	c.JSON(http.StatusOK, keyIngredients)
+	ingredient, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ingredient)
 }
 
 // Create a new key ingredient
-func CreateKeyIngredient(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	keyIngredientsIDStr := c.Query("key_ingredients_id")
-	keyingredient := c.Query("key_ingredients")
-	// Convert keyIngredientsID to int (since it will be a string from the query)
-	keyIngredientsID, err := strconv.Atoi(keyIngredientsIDStr)
+func CreateKeyIngredient(c *gin.Context, store Store) {
+	newKeyIngredient, err := bindKeyIngredients(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid key_ingredients_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the new key ingredient struct
-	newKeyIngredient := KeyIngredients{
-		KeyIngredientsID: keyIngredientsID,
-		KeyIngredient:    keyingredient,
+	// Validate before writing
+	if fields := Validate(newKeyIngredient); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
 	}
-	// Execute the SQL statement with parameters
-	result, err := db.Exec("INSERT INTO Key_Ingredients (Key_Ingredients_ID, Key_Ingredients) VALUES (?, ?);", newKeyIngredient.KeyIngredientsID, newKeyIngredient.KeyIngredient)
+	// Persist the key ingredient
+	newKeyIngredient, err = store.Create(newKeyIngredient)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Retrieve and set the last inserted ID if needed
-	id, _ := result.LastInsertId()
-	newKeyIngredient.KeyIngredientsID = int(id)
-	// Send the response
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/key_ingredients/%d", newKeyIngredient.KeyIngredientsID))
 	c.JSON(http.StatusCreated, newKeyIngredient)
 }
 
 // Update a key ingredient
-func UpdateKeyIngredient(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	keyingredientidStr := c.Query("key_ingredients_id")
-	keyingredient := c.Query("key_ingredients")
+func UpdateKeyIngredient(c *gin.Context, store Store) {
+	updatedKeyIngredient, err := bindKeyIngredients(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := ValidateReplacement(updatedKeyIngredient); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedKeyIngredient)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedKeyIngredient.KeyIngredientsID))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
 
-	// Convert id to an integer
-	id, err := strconv.Atoi(keyingredientidStr)
+// Update only the supplied fields of a key ingredient
+func PatchKeyIngredient(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("key_ingredients_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("key_ingredients_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid id"})
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("key_ingredients_id", patched.KeyIngredientsID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
 		return
 	}
-	// Prepare the updated key ingredient struct
-	updatedKeyIngredient := KeyIngredients{
-		KeyIngredientsID: id,
-		KeyIngredient:    keyingredient,
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
 	}
-	// Execute the SQL statement with parameters
-	_, err = db.Exec("UPDATE Key_Ingredients SET Key_Ingredients = ? WHERE Key_Ingredients_ID = ?", updatedKeyIngredient.KeyIngredient, updatedKeyIngredient.KeyIngredientsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Send the response
-	c.JSON(http.StatusOK, updatedKeyIngredient)
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
 }
 
 // Delete a key ingredient
-func DeleteKeyIngredient(c *gin.Context, db *sql.DB) {
-	id := c.Param("key_ingredients_id")
-	_, err := db.Exec("DELETE FROM Key_Ingredients WHERE Key_Ingredients_ID = ?", id)
+func DeleteKeyIngredient(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("key_ingredients_id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, api_error.InvalidParam("key_ingredients_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Key Ingredient deleted"})
 }
+
+// bindKeyIngredients reads a key ingredient from the JSON request body.
+// Requests without one fall back to the deprecated key_ingredients_id and
+// key_ingredients query parameters.
+func bindKeyIngredients(c *gin.Context) (KeyIngredients, error) {
+	var ingredient KeyIngredients
+	if request.HasJSONBody(c) {
+		err := request.BindJSON(c, &ingredient)
+		return ingredient, err
+	}
+	request.DeprecatedQuery(c)
+	if err := request.QueryInts(c, request.IntParam{Name: "key_ingredients_id", Dest: &ingredient.KeyIngredientsID, Optional: true}); err != nil {
+		return ingredient, err
+	}
+	ingredient.KeyIngredient = c.Query("key_ingredients")
+	return ingredient, nil
+}
+
+// notFound reports an unknown Key_Ingredients_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Key ingredient %d not found", id))
+}
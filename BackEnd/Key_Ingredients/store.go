@@ -0,0 +1,153 @@
+package key_ingredients
+
+import (
+	"BackEnd/Database"
+	"database/sql"
+	"sort"
+	"sync"
+)
+
+// Store is the persistence boundary for key ingredients. Lookups, updates and deletes of
+// an unknown Key_Ingredients_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned.
+type Store interface {
+	List() ([]KeyIngredients, error)
+	Get(id int) (KeyIngredients, error)
+	Create(ingredient KeyIngredients) (KeyIngredients, error)
+	Update(ingredient KeyIngredients) (KeyIngredients, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Key_Ingredients"
+
+var Columns = []string{"Key_Ingredients_ID", "Key_Ingredients", "Time_Of_Day"}
+
+// SQLStore keeps key ingredients in the Key_Ingredients table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]KeyIngredients, error) {
+	rows, err := s.db.Query("SELECT Key_Ingredients_ID, Key_Ingredients, Time_Of_Day FROM Key_Ingredients")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var keyIngredients []KeyIngredients
+	for rows.Next() {
+		var ingredient KeyIngredients
+		if err := rows.Scan(&ingredient.KeyIngredientsID, &ingredient.KeyIngredient, &ingredient.TimeOfDay); err != nil {
+			return nil, err
+		}
+		keyIngredients = append(keyIngredients, ingredient)
+	}
+	return keyIngredients, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (KeyIngredients, error) {
+	var ingredient KeyIngredients
+	err := s.db.QueryRow("SELECT Key_Ingredients_ID, Key_Ingredients, Time_Of_Day FROM Key_Ingredients WHERE Key_Ingredients_ID = ?", id).
+		Scan(&ingredient.KeyIngredientsID, &ingredient.KeyIngredient, &ingredient.TimeOfDay)
+	return ingredient, err
+}
+
+func (s *SQLStore) Create(ingredient KeyIngredients) (KeyIngredients, error) {
+	result, err := s.db.Exec("INSERT INTO Key_Ingredients (Key_Ingredients, Time_Of_Day) VALUES (?, ?)", ingredient.KeyIngredient, ingredient.TimeOfDay)
+	if err != nil {
+		return KeyIngredients{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return KeyIngredients{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(ingredient KeyIngredients) (KeyIngredients, error) {
+	result, err := s.db.Exec("UPDATE Key_Ingredients SET Key_Ingredients = ?, Time_Of_Day = ? WHERE Key_Ingredients_ID = ?",
+		ingredient.KeyIngredient, ingredient.TimeOfDay, ingredient.KeyIngredientsID)
+	if err != nil {
+		return KeyIngredients{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return KeyIngredients{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(ingredient.KeyIngredientsID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Key_Ingredients WHERE Key_Ingredients_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps key ingredients in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]KeyIngredients
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]KeyIngredients)}
+}
+
+func (s *MemoryStore) List() ([]KeyIngredients, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var keyIngredients []KeyIngredients
+	for _, ingredient := range s.rows {
+		keyIngredients = append(keyIngredients, ingredient)
+	}
+	sort.Slice(keyIngredients, func(i, j int) bool { return keyIngredients[i].KeyIngredientsID < keyIngredients[j].KeyIngredientsID })
+	return keyIngredients, nil
+}
+
+func (s *MemoryStore) Get(id int) (KeyIngredients, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ingredient, ok := s.rows[id]
+	if !ok {
+		return KeyIngredients{}, sql.ErrNoRows
+	}
+	return ingredient, nil
+}
+
+func (s *MemoryStore) Create(ingredient KeyIngredients) (KeyIngredients, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	ingredient.KeyIngredientsID = s.lastID
+	s.rows[ingredient.KeyIngredientsID] = ingredient
+	return ingredient, nil
+}
+
+func (s *MemoryStore) Update(ingredient KeyIngredients) (KeyIngredients, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[ingredient.KeyIngredientsID]; !ok {
+		return KeyIngredients{}, sql.ErrNoRows
+	}
+	s.rows[ingredient.KeyIngredientsID] = ingredient
+	return ingredient, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
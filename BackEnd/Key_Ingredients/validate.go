@@ -0,0 +1,28 @@
+package key_ingredients
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+)
+
+// Width of the Key_Ingredients column
+const maxNameLength = 50
+
+// Validate checks a key ingredient before it is written and returns every problem
+// found, or nil. New rows are numbered by the database, so the key_ingredients_id
+// is only checked by ValidateReplacement.
+func Validate(ingredient KeyIngredients) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("ingredient", ingredient.KeyIngredient)
+	v.MaxLength("ingredient", ingredient.KeyIngredient, maxNameLength)
+	v.OneOf("time_of_day", ingredient.TimeOfDay, "", "AM", "PM")
+	return v.Errors()
+}
+
+// ValidateReplacement checks a key ingredient sent to replace a stored row,
+// which must also name the row's key_ingredients_id.
+func ValidateReplacement(ingredient KeyIngredients) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("key_ingredients_id", ingredient.KeyIngredientsID)
+	return append(v.Errors(), Validate(ingredient)...)
+}
@@ -1,99 +1,183 @@
 package product_type
 
 import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
 	"database/sql"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
 )
 
+// ProductType is a kind of product, such as a cleanser. StepOrder places the
+// type in a skincare routine, lowest applied first; types without one are not
+// routine steps. TimeOfDay limits the step to the "AM" or "PM" routine, or is
+// empty for both.
 type ProductType struct {
 	ProductTypeID int    `json:"product_type_id"`
 	ProductType   string `json:"product_type"`
+	StepOrder     *int   `json:"step_order"`
+	TimeOfDay     string `json:"time_of_day"`
 }
 
 // Get all product types
-func GetProductTypes(c *gin.Context, db *sql.DB) {
-	rows, err := db.Query("SELECT Product_Type_ID, Product_Type FROM Product_Type")
+func GetProductTypes(c *gin.Context, store Store) {
+	productTypes, err := store.List()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	defer rows.Close()
-	var productTypes []ProductType
-	for rows.Next() {
-		var productType ProductType
-		if err := rows.Scan(&productType.ProductTypeID, &productType.ProductType); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		productTypes = append(productTypes, productType)
-	}
 	c.JSON(http.StatusOK, productTypes)
 }
 
+// Get a product type by ID
+func GetProductType(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("product_type_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("product_type_id"))
+		return
+	}
+	productType, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, productType)
+}
+
 // Create a new product type
-func CreateProductType(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	productTypeIDStr := c.Query("product_type_id")
-	productType := c.Query("product_type")
-	// Convert productTypeID to int (since it will be a string from the query)
-	productTypeID, err := strconv.Atoi(productTypeIDStr)
+func CreateProductType(c *gin.Context, store Store) {
+	newProductType, err := bindProductType(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the new product type struct
-	newProductType := ProductType{
-		ProductTypeID: productTypeID,
-		ProductType:   productType,
+	// Validate before writing
+	if fields := Validate(newProductType); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
 	}
-	// Execute the SQL statement with parameters
-	result, err := db.Exec("INSERT INTO Product_Type (Product_Type_ID, Product_Type) VALUES (?, ?);", newProductType.ProductTypeID, newProductType.ProductType)
+	// Persist the product type
+	newProductType, err = store.Create(newProductType)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Retrieve and set the last inserted ID if needed
-	id, _ := result.LastInsertId()
-	newProductType.ProductTypeID = int(id)
-	// Send the response
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/product_type/%d", newProductType.ProductTypeID))
 	c.JSON(http.StatusCreated, newProductType)
 }
 
 // Update a product type
-func UpdateProductType(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	productTypeIDStr := c.Query("product_type_id")
-	productType := c.Query("product_type")
-	// Convert productTypeID to an integer
-	productTypeID, err := strconv.Atoi(productTypeIDStr)
+func UpdateProductType(c *gin.Context, store Store) {
+	updatedProductType, err := bindProductType(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the updated product type struct
-	updatedProductType := ProductType{
-		ProductTypeID: productTypeID,
-		ProductType:   productType,
+	// Validate before writing
+	if fields := ValidateReplacement(updatedProductType); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedProductType)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedProductType.ProductTypeID))
+		return
 	}
-	// Execute the SQL statement with parameters
-	_, err = db.Exec("UPDATE Product_Type SET Product_Type = ? WHERE Product_Type_ID = ?", updatedProductType.ProductType, updatedProductType.ProductTypeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Send the response
-	c.JSON(http.StatusOK, updatedProductType)
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of a product type
+func PatchProductType(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("product_type_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("product_type_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("product_type_id", patched.ProductTypeID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
 }
 
 // Delete a product type
-func DeleteProductType(c *gin.Context, db *sql.DB) {
-	id := c.Param("product_type_id")
-	_, err := db.Exec("DELETE FROM Product_Type WHERE Product_Type_ID = ?", id)
+func DeleteProductType(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("product_type_id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, api_error.InvalidParam("product_type_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Product Type deleted"})
 }
+
+// bindProductType reads a product type from the JSON request body. Requests
+// without one fall back to the deprecated product_type_id and product_type query
+// parameters.
+func bindProductType(c *gin.Context) (ProductType, error) {
+	var productType ProductType
+	if request.HasJSONBody(c) {
+		err := request.BindJSON(c, &productType)
+		return productType, err
+	}
+	request.DeprecatedQuery(c)
+	if err := request.QueryInts(c, request.IntParam{Name: "product_type_id", Dest: &productType.ProductTypeID, Optional: true}); err != nil {
+		return productType, err
+	}
+	productType.ProductType = c.Query("product_type")
+	return productType, nil
+}
+
+// notFound reports an unknown Product_Type_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Product type %d not found", id))
+}
@@ -0,0 +1,154 @@
+package product_type
+
+import (
+	"BackEnd/Database"
+	"database/sql"
+	"sort"
+	"sync"
+)
+
+// Store is the persistence boundary for product types. Lookups, updates and deletes of
+// an unknown Product_Type_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned.
+type Store interface {
+	List() ([]ProductType, error)
+	Get(id int) (ProductType, error)
+	Create(productType ProductType) (ProductType, error)
+	Update(productType ProductType) (ProductType, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Product_Type"
+
+var Columns = []string{"Product_Type_ID", "Product_Type", "Step_Order", "Time_Of_Day"}
+
+// SQLStore keeps product types in the Product_Type table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]ProductType, error) {
+	rows, err := s.db.Query("SELECT Product_Type_ID, Product_Type, Step_Order, Time_Of_Day FROM Product_Type")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var productTypes []ProductType
+	for rows.Next() {
+		var productType ProductType
+		if err := rows.Scan(&productType.ProductTypeID, &productType.ProductType, &productType.StepOrder, &productType.TimeOfDay); err != nil {
+			return nil, err
+		}
+		productTypes = append(productTypes, productType)
+	}
+	return productTypes, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (ProductType, error) {
+	var productType ProductType
+	err := s.db.QueryRow("SELECT Product_Type_ID, Product_Type, Step_Order, Time_Of_Day FROM Product_Type WHERE Product_Type_ID = ?", id).
+		Scan(&productType.ProductTypeID, &productType.ProductType, &productType.StepOrder, &productType.TimeOfDay)
+	return productType, err
+}
+
+func (s *SQLStore) Create(productType ProductType) (ProductType, error) {
+	result, err := s.db.Exec("INSERT INTO Product_Type (Product_Type, Step_Order, Time_Of_Day) VALUES (?, ?, ?)",
+		productType.ProductType, productType.StepOrder, productType.TimeOfDay)
+	if err != nil {
+		return ProductType{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return ProductType{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(productType ProductType) (ProductType, error) {
+	result, err := s.db.Exec("UPDATE Product_Type SET Product_Type = ?, Step_Order = ?, Time_Of_Day = ? WHERE Product_Type_ID = ?",
+		productType.ProductType, productType.StepOrder, productType.TimeOfDay, productType.ProductTypeID)
+	if err != nil {
+		return ProductType{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return ProductType{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(productType.ProductTypeID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Product_Type WHERE Product_Type_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps product types in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]ProductType
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]ProductType)}
+}
+
+func (s *MemoryStore) List() ([]ProductType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var productTypes []ProductType
+	for _, productType := range s.rows {
+		productTypes = append(productTypes, productType)
+	}
+	sort.Slice(productTypes, func(i, j int) bool { return productTypes[i].ProductTypeID < productTypes[j].ProductTypeID })
+	return productTypes, nil
+}
+
+func (s *MemoryStore) Get(id int) (ProductType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	productType, ok := s.rows[id]
+	if !ok {
+		return ProductType{}, sql.ErrNoRows
+	}
+	return productType, nil
+}
+
+func (s *MemoryStore) Create(productType ProductType) (ProductType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	productType.ProductTypeID = s.lastID
+	s.rows[productType.ProductTypeID] = productType
+	return productType, nil
+}
+
+func (s *MemoryStore) Update(productType ProductType) (ProductType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[productType.ProductTypeID]; !ok {
+		return ProductType{}, sql.ErrNoRows
+	}
+	s.rows[productType.ProductTypeID] = productType
+	return productType, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
@@ -0,0 +1,31 @@
+package product_type
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+)
+
+// Width of the Product_Type column
+const maxNameLength = 50
+
+// Validate checks a product type before it is written and returns every problem
+// found, or nil. New rows are numbered by the database, so the product_type_id
+// is only checked by ValidateReplacement.
+func Validate(productType ProductType) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("product_type", productType.ProductType)
+	v.MaxLength("product_type", productType.ProductType, maxNameLength)
+	if productType.StepOrder != nil {
+		v.Positive("step_order", *productType.StepOrder)
+	}
+	v.OneOf("time_of_day", productType.TimeOfDay, "", "AM", "PM")
+	return v.Errors()
+}
+
+// ValidateReplacement checks a product type sent to replace a stored row,
+// which must also name the row's product_type_id.
+func ValidateReplacement(productType ProductType) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("product_type_id", productType.ProductTypeID)
+	return append(v.Errors(), Validate(productType)...)
+}
@@ -0,0 +1,91 @@
+package products
+
+import (
+	"BackEnd/Ingredient_Attribute"
+	"BackEnd/Ingredient_Synonym"
+	"strings"
+)
+
+// loadBadges fills in the badges of products from the attribute table. Each
+// badge's condition is checked against every ingredient a product lists, the
+// ones it only may contain included; a product without a parsed list earns
+// none.
+func (s *SQLStore) loadBadges(products []*Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+	byID := make(map[int]*Product, len(products))
+	args := make([]interface{}, 0, len(products))
+	for _, product := range products {
+		product.Badges = []string{}
+		byID[product.ProductID] = product
+		args = append(args, product.ProductID)
+	}
+	broken := make([]string, len(ingredient_attribute.Badges))
+	for i, badge := range ingredient_attribute.Badges {
+		broken[i] = "MAX(CASE WHEN " + badge.Condition + " THEN 1 ELSE 0 END)"
+	}
+	rows, err := s.db.Query(`
+    SELECT pi.Product_ID, `+strings.Join(broken, ", ")+`
+    FROM Product_Ingredient pi
+    LEFT JOIN Ingredient_Attribute a ON LOWER(a.Ingredient) = LOWER(pi.Ingredient)
+    WHERE pi.Product_ID IN (?`+strings.Repeat(", ?", len(args)-1)+`)
+    GROUP BY pi.Product_ID`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var productID int
+		flags := make([]int, len(broken))
+		dest := []interface{}{&productID}
+		for i := range flags {
+			dest = append(dest, &flags[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		for i, badge := range ingredient_attribute.Badges {
+			if flags[i] == 0 {
+				byID[productID].Badges = append(byID[productID].Badges, badge.Name)
+			}
+		}
+	}
+	return rows.Err()
+}
+
+// attributeIndex maps the name of every ingredient in the attribute table,
+// as attributeKey spells it, to its attributes.
+func (s *MemoryStore) attributeIndex() (map[string]ingredient_attribute.IngredientAttribute, error) {
+	attributes, err := s.attributes.List()
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]ingredient_attribute.IngredientAttribute, len(attributes))
+	for _, attribute := range attributes {
+		index[attributeKey(attribute.Ingredient)] = attribute
+	}
+	return index, nil
+}
+
+// badges returns the badges earned by the product with the given ID, the way
+// loadBadges derives them. Callers must hold s.mu.
+func (s *MemoryStore) badges(productID int, index map[string]ingredient_attribute.IngredientAttribute) []string {
+	ingredients := s.ingredients[productID]
+	if len(ingredients) == 0 {
+		return []string{}
+	}
+	attributes := make([]ingredient_attribute.IngredientAttribute, len(ingredients))
+	for i, ingredient := range ingredients {
+		// Unknown ingredients stay the zero value, which Earned expects
+		attributes[i] = index[attributeKey(ingredient.Ingredient)]
+	}
+	return ingredient_attribute.Earned(attributes)
+}
+
+// attributeKey is the lookup key of an ingredient name: tidied as the parser
+// tidies names, so that "Alcohol Denat." finds "Alcohol Denat", and
+// lowercased.
+func attributeKey(name string) string {
+	return strings.ToLower(ingredient_synonym.Clean(name))
+}
@@ -0,0 +1,201 @@
+package products
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Request"
+	"BackEnd/Validation"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// How many products one comparison takes
+const (
+	minCompared = 2
+	maxCompared = 5
+)
+
+// Comparison sets products side by side. Products are in the order they were
+// asked for; the ingredient lists are canonical names in listed order.
+type Comparison struct {
+	Products          []Compared `json:"products"`
+	SharedIngredients []string   `json:"shared_ingredients"`
+	Concerns          []Coverage `json:"concerns"`
+	SkinTypes         []Coverage `json:"skin_types"`
+	SameBrand         bool       `json:"same_brand"`
+}
+
+// Compared is one product of a comparison, with what sets it apart.
+type Compared struct {
+	Product
+	UniqueIngredients []string `json:"unique_ingredients"`
+	KeyActives        []Active `json:"key_actives"`
+}
+
+// Active is a listed ingredient that has a concentration or is named like a
+// key ingredient. Concentration is nil if the list gives none.
+type Active struct {
+	Ingredient    string   `json:"ingredient"`
+	Position      int      `json:"position"`
+	Concentration *float64 `json:"concentration"`
+}
+
+// Coverage is a concern or skin type and the compared products linked to it.
+type Coverage struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	ProductIDs []int  `json:"product_ids"`
+}
+
+// Compare two to five products named by ids: the ingredients they all list,
+// those only one lists, each one's actives, the concerns and skin types each
+// is linked to, and whether they share a brand. Ingredients a product only
+// may contain are left out.
+func CompareProducts(c *gin.Context, store Store, refs References, normalizer *ingredient_synonym.Normalizer) {
+	var ids []int
+	if err := request.QueryIntLists(c, request.IntListParam{Name: "ids", Dest: &ids}); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	ids = distinct(ids)
+	if len(ids) < minCompared || len(ids) > maxCompared {
+		api_error.Respond(c, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+			Field:   "ids",
+			Code:    api_error.Invalid,
+			Message: fmt.Sprintf("ids must name between %d and %d products", minCompared, maxCompared),
+		}))
+		return
+	}
+	// Every product must exist
+	var v validation.Validator
+	for _, id := range ids {
+		if err := v.Reference("ids", id, func(id int) error { _, err := store.Get(id); return err }); err != nil {
+			api_error.Respond(c, err)
+			return
+		}
+	}
+	if fields := v.Errors(); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+
+	named, _, err := store.Page(PageQuery{Filter: Filter{ProductIDs: ids}, Limit: len(ids)})
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	byID := make(map[int]Product, len(named))
+	for _, product := range named {
+		byID[product.ProductID] = product
+	}
+	actives, err := activeNames(refs.KeyIngredients, normalizer)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+
+	// Count how many of the products list each ingredient
+	lists := make([][]Ingredient, len(ids))
+	listedBy := make(map[string]int)
+	for i, id := range ids {
+		ingredients, err := store.Ingredients(id)
+		if err != nil {
+			api_error.Respond(c, err)
+			return
+		}
+		lists[i] = certainIngredients(ingredients)
+		for _, ingredient := range lists[i] {
+			listedBy[strings.ToLower(ingredient.Ingredient)]++
+		}
+	}
+
+	comparison := Comparison{Products: []Compared{}, SharedIngredients: []string{}, SameBrand: true}
+	for i, id := range ids {
+		compared := Compared{Product: byID[id], UniqueIngredients: []string{}, KeyActives: []Active{}}
+		for _, ingredient := range lists[i] {
+			key := strings.ToLower(ingredient.Ingredient)
+			switch listedBy[key] {
+			case len(ids):
+				if i == 0 {
+					comparison.SharedIngredients = append(comparison.SharedIngredients, ingredient.Ingredient)
+				}
+			case 1:
+				compared.UniqueIngredients = append(compared.UniqueIngredients, ingredient.Ingredient)
+			}
+			if ingredient.Concentration != nil || actives[key] {
+				compared.KeyActives = append(compared.KeyActives, Active{
+					Ingredient:    ingredient.Ingredient,
+					Position:      ingredient.Position,
+					Concentration: ingredient.Concentration,
+				})
+			}
+		}
+		if compared.BrandID != byID[ids[0]].BrandID {
+			comparison.SameBrand = false
+		}
+		comparison.Products = append(comparison.Products, compared)
+	}
+
+	concerns, err := refs.Concerns.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	concernNames := make(map[int]string, len(concerns))
+	for _, concern := range concerns {
+		concernNames[concern.ConcernID] = concern.Concern
+	}
+	skinTypes, err := refs.SkinTypes.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	skinTypeNames := make(map[int]string, len(skinTypes))
+	for _, skinType := range skinTypes {
+		skinTypeNames[skinType.SkinTypeID] = skinType.SkinType
+	}
+	comparison.Concerns = coverage(comparison.Products, concernNames, func(p Product) []int { return p.ConcernIDs })
+	comparison.SkinTypes = coverage(comparison.Products, skinTypeNames, func(p Product) []int { return p.SkinTypeIDs })
+	c.JSON(http.StatusOK, gin.H{"data": comparison})
+}
+
+// certainIngredients returns the ingredients a product certainly contains,
+// leaving out those it only may contain and later repeats of a name.
+func certainIngredients(ingredients []Ingredient) []Ingredient {
+	var certain []Ingredient
+	seen := make(map[string]bool)
+	for _, ingredient := range ingredients {
+		key := strings.ToLower(ingredient.Ingredient)
+		if ingredient.MayContain || seen[key] {
+			continue
+		}
+		seen[key] = true
+		certain = append(certain, ingredient)
+	}
+	return certain
+}
+
+// coverage lists each concern or skin type that ids links any of the
+// compared products to, with the products linked to it, ordered by ID.
+func coverage(compared []Compared, names map[int]string, ids func(p Product) []int) []Coverage {
+	byID := make(map[int]*Coverage)
+	for _, product := range compared {
+		for _, id := range ids(product.Product) {
+			covered, ok := byID[id]
+			if !ok {
+				covered = &Coverage{ID: id, Name: names[id]}
+				byID[id] = covered
+			}
+			covered.ProductIDs = append(covered.ProductIDs, product.ProductID)
+		}
+	}
+	covered := make([]Coverage, 0, len(byID))
+	for _, entry := range byID {
+		covered = append(covered, *entry)
+	}
+	sort.Slice(covered, func(i, j int) bool { return covered[i].ID < covered[j].ID })
+	return covered
+}
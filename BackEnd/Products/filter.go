@@ -0,0 +1,332 @@
+package products
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Ingredient_Attribute"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Request"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Most names include_ingredient and exclude_ingredient each take
+const maxIngredientFilters = 20
+
+// Filter narrows the catalog. A product must match every non-empty field,
+// and any one of the IDs listed for a field. Concerns, skin types and key
+// ingredients match any of a product's links, not only the primary one.
+type Filter struct {
+	ProductIDs       []int
+	ConcernIDs       []int
+	SkinTypeIDs      []int
+	BrandIDs         []int
+	ProductTypeIDs   []int
+	KeyIngredientIDs []int
+	Name             string // substring of the product name, ignoring case
+	Ingredients      IngredientFilter
+}
+
+// IngredientFilter narrows products by their parsed ingredient lists. A
+// product must list every included ingredient and none of the excluded
+// ones. Names are canonical and compare without regard to case.
+type IngredientFilter struct {
+	Include []string
+	// Exclude also rules out products that only may contain an ingredient.
+	Exclude []string
+	// MinConcentration, if set, is the lowest percentage at which each
+	// included ingredient must be listed.
+	MinConcentration *float64
+	// Badges are the names of the badges a product must have earned.
+	Badges []string
+}
+
+// parseFilter reads the filter query parameters of GET /products.
+func parseFilter(c *gin.Context, normalizer *ingredient_synonym.Normalizer) (Filter, error) {
+	var filter Filter
+	err := request.QueryIntLists(c,
+		request.IntListParam{Name: "product_id", Dest: &filter.ProductIDs},
+		request.IntListParam{Name: "concern_id", Dest: &filter.ConcernIDs},
+		request.IntListParam{Name: "skin_type_id", Dest: &filter.SkinTypeIDs},
+		request.IntListParam{Name: "brand_id", Dest: &filter.BrandIDs},
+		request.IntListParam{Name: "product_type_id", Dest: &filter.ProductTypeIDs},
+		request.IntListParam{Name: "key_ingredients_id", Dest: &filter.KeyIngredientIDs},
+	)
+	if err != nil {
+		return Filter{}, err
+	}
+	filter.Name = strings.TrimSpace(c.Query("name"))
+	if utf8.RuneCountInString(filter.Name) > maxNameLength {
+		return Filter{}, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+			Field:   "name",
+			Code:    api_error.TooLong,
+			Message: fmt.Sprintf("name must be at most %d characters", maxNameLength),
+		})
+	}
+	filter.Ingredients, err = parseIngredientFilter(c, normalizer)
+	if err != nil {
+		return Filter{}, err
+	}
+	return filter, nil
+}
+
+// ParseSelectOptions reads the query parameters of the selection
+// endpoints: the ingredient filter and wildcards, which defaults to true.
+func ParseSelectOptions(c *gin.Context, normalizer *ingredient_synonym.Normalizer) (SelectOptions, error) {
+	options := SelectOptions{Wildcards: true}
+	if raw, ok := c.GetQuery("wildcards"); ok {
+		wildcards, err := strconv.ParseBool(raw)
+		if err != nil {
+			return SelectOptions{}, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+				Field:   "wildcards",
+				Code:    api_error.Invalid,
+				Message: "wildcards must be true or false",
+			})
+		}
+		options.Wildcards = wildcards
+	}
+	var err error
+	options.Ingredients, err = parseIngredientFilter(c, normalizer)
+	return options, err
+}
+
+// parseIngredientFilter reads include_ingredient, exclude_ingredient,
+// min_concentration and badge, which may be repeated. Ingredient names may
+// contain commas, as in "1,2-Hexanediol", so each name is its own parameter.
+// Names are mapped onto canonical ones the way parsed ingredient lists are.
+func parseIngredientFilter(c *gin.Context, normalizer *ingredient_synonym.Normalizer) (IngredientFilter, error) {
+	var filter IngredientFilter
+	var fields []api_error.FieldError
+	read := func(param string) []string {
+		names := c.QueryArray(param)
+		if len(names) > maxIngredientFilters {
+			fields = append(fields, api_error.FieldError{
+				Field:   param,
+				Code:    api_error.Invalid,
+				Message: fmt.Sprintf("Give at most %d %s parameters", maxIngredientFilters, param),
+			})
+			return nil
+		}
+		var valid []string
+		for _, name := range names {
+			if ingredient_synonym.Clean(name) == "" || utf8.RuneCountInString(name) > maxNameLength {
+				fields = append(fields, api_error.FieldError{
+					Field:   param,
+					Code:    api_error.Invalid,
+					Message: fmt.Sprintf("%s must be between 1 and %d characters", param, maxNameLength),
+				})
+				continue
+			}
+			valid = append(valid, name)
+		}
+		return valid
+	}
+	filter.Include = read("include_ingredient")
+	filter.Exclude = read("exclude_ingredient")
+	if raw, ok := c.GetQuery("min_concentration"); ok {
+		value, err := strconv.ParseFloat(raw, 64)
+		switch {
+		case err != nil || value <= 0 || value > 100:
+			fields = append(fields, api_error.FieldError{
+				Field:   "min_concentration",
+				Code:    api_error.Invalid,
+				Message: "min_concentration must be a percentage above 0 and at most 100",
+			})
+		case len(c.QueryArray("include_ingredient")) == 0:
+			fields = append(fields, api_error.FieldError{
+				Field:   "min_concentration",
+				Code:    api_error.Invalid,
+				Message: "min_concentration applies to include_ingredient, which is missing",
+			})
+		default:
+			filter.MinConcentration = &value
+		}
+	}
+	for _, name := range c.QueryArray("badge") {
+		badge, ok := ingredient_attribute.FindBadge(name)
+		if !ok {
+			fields = append(fields, api_error.FieldError{
+				Field:   "badge",
+				Code:    api_error.Invalid,
+				Message: "badge must be one of " + strings.Join(ingredient_attribute.BadgeNames(), ", "),
+			})
+			continue
+		}
+		filter.Badges = append(filter.Badges, badge.Name)
+	}
+	if fields != nil {
+		return IngredientFilter{}, api_error.BadRequest("Invalid query parameters", fields...)
+	}
+
+	for _, names := range []*[]string{&filter.Include, &filter.Exclude} {
+		for i, name := range *names {
+			name, err := normalizer.Canonical(name)
+			if err != nil {
+				return IngredientFilter{}, err
+			}
+			(*names)[i] = name
+		}
+	}
+	return filter, nil
+}
+
+// Matches reports whether product passes the filter.
+func (f Filter) Matches(product Product) bool {
+	return anyOf(f.ProductIDs, product.ProductID) &&
+		anyLinked(f.ConcernIDs, product.ConcernIDs) &&
+		anyLinked(f.SkinTypeIDs, product.SkinTypeIDs) &&
+		anyOf(f.BrandIDs, product.BrandID) &&
+		anyOf(f.ProductTypeIDs, product.ProductTypeID) &&
+		anyLinked(f.KeyIngredientIDs, product.KeyIngredientIDs) &&
+		strings.Contains(strings.ToLower(product.ProductName), strings.ToLower(f.Name))
+}
+
+// Matches reports whether a product with the given parsed ingredients and
+// badges passes the filter.
+func (f IngredientFilter) Matches(ingredients []Ingredient, badges []string) bool {
+	for _, name := range f.Badges {
+		if !containsName(badges, name) {
+			return false
+		}
+	}
+	for _, name := range f.Include {
+		found := false
+		for _, ingredient := range ingredients {
+			if !ingredient.MayContain && strings.EqualFold(ingredient.Ingredient, name) &&
+				(f.MinConcentration == nil || ingredient.Concentration != nil && *ingredient.Concentration >= *f.MinConcentration) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, name := range f.Exclude {
+		for _, ingredient := range ingredients {
+			if strings.EqualFold(ingredient.Ingredient, name) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// where renders the filter as a WHERE clause over Products p, with its
+// arguments. It is empty when nothing is filtered.
+func (f Filter) where() (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	in := func(column string, ids []int) {
+		if len(ids) == 0 {
+			return
+		}
+		conditions = append(conditions, column+" IN (?"+strings.Repeat(", ?", len(ids)-1)+")")
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+	linked := func(table, column string, ids []int) {
+		if len(ids) == 0 {
+			return
+		}
+		conditions = append(conditions, "p.Product_ID IN (SELECT Product_ID FROM "+table+" WHERE "+column+
+			" IN (?"+strings.Repeat(", ?", len(ids)-1)+"))")
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+	in("p.Product_ID", f.ProductIDs)
+	linked("Product_Concern", "Concern_ID", f.ConcernIDs)
+	linked("Product_Skin_Type", "Skin_Type_ID", f.SkinTypeIDs)
+	in("p.Brand_ID", f.BrandIDs)
+	in("p.Product_Type_ID", f.ProductTypeIDs)
+	linked("Product_Key_Ingredient", "Key_Ingredients_ID", f.KeyIngredientIDs)
+	if f.Name != "" {
+		// ! escapes the wildcards; unlike \ it means the same to MySQL and SQLite
+		conditions = append(conditions, "LOWER(p.Product_Name) LIKE ? ESCAPE '!'")
+		args = append(args, "%"+likeEscaper.Replace(strings.ToLower(f.Name))+"%")
+	}
+	ingredientConditions, ingredientArgs := f.Ingredients.conditions()
+	conditions = append(conditions, ingredientConditions...)
+	args = append(args, ingredientArgs...)
+	if conditions == nil {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// conditions renders the filter as conditions over Products p, to be joined
+// with AND, with their arguments.
+func (f IngredientFilter) conditions() ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	for _, name := range f.Include {
+		condition := `EXISTS (SELECT 1 FROM Product_Ingredient pi
+        WHERE pi.Product_ID = p.Product_ID AND LOWER(pi.Ingredient) = ? AND NOT pi.May_Contain`
+		args = append(args, strings.ToLower(name))
+		if f.MinConcentration != nil {
+			condition += " AND pi.Concentration >= ?"
+			args = append(args, *f.MinConcentration)
+		}
+		conditions = append(conditions, condition+")")
+	}
+	for _, name := range f.Exclude {
+		conditions = append(conditions, `NOT EXISTS (SELECT 1 FROM Product_Ingredient pi
+        WHERE pi.Product_ID = p.Product_ID AND LOWER(pi.Ingredient) = ?)`)
+		args = append(args, strings.ToLower(name))
+	}
+	if len(f.Badges) > 0 {
+		// Like loadBadges, a product without a parsed list earns none
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM Product_Ingredient pi WHERE pi.Product_ID = p.Product_ID)")
+	}
+	for _, name := range f.Badges {
+		badge, _ := ingredient_attribute.FindBadge(name)
+		conditions = append(conditions, `NOT EXISTS (SELECT 1 FROM Product_Ingredient pi
+        LEFT JOIN Ingredient_Attribute a ON LOWER(a.Ingredient) = LOWER(pi.Ingredient)
+        WHERE pi.Product_ID = p.Product_ID AND (`+badge.Condition+`))`)
+	}
+	return conditions, args
+}
+
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// anyLinked reports whether any of linked is one of ids, treating an empty
+// list as no constraint.
+func anyLinked(ids []int, linked []int) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, id := range linked {
+		if anyOf(ids, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// anyOf reports whether id is one of ids, treating an empty list as no
+// constraint.
+func anyOf(ids []int, id int) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// containsName reports whether names holds name, ignoring case.
+func containsName(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package products
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Inci"
+	"BackEnd/Ingredient_Synonym"
+	"database/sql"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// Ingredient is one entry of a product's parsed ingredient list. Ingredient
+// is the canonical name from the synonym table, ListedAs the name as the
+// product lists it.
+type Ingredient struct {
+	Position      int      `json:"position"`
+	Ingredient    string   `json:"ingredient"`
+	ListedAs      string   `json:"listed_as"`
+	Concentration *float64 `json:"concentration"`
+	MayContain    bool     `json:"may_contain"`
+}
+
+// IngredientTable and IngredientColumns name the table the parsed
+// ingredient lists are kept in, for the schema check.
+const IngredientTable = "Product_Ingredient"
+
+var IngredientColumns = []string{"Product_ID", "Position", "Ingredient", "Listed_As", "Concentration", "May_Contain"}
+
+// parseIngredients parses an All_Ingredients list and names each entry
+// canonically. Of a slashed name such as "Aqua/Water/Eau", the first part
+// that is a known name is used when the whole name is not.
+func parseIngredients(list string, normalizer *ingredient_synonym.Normalizer) ([]Ingredient, error) {
+	var ingredients []Ingredient
+	for _, entry := range inci.Parse(list) {
+		normalized, err := normalizer.Normalize(entry.Name)
+		if err != nil {
+			return nil, err
+		}
+		if normalized.Match == ingredient_synonym.MatchNone {
+			for _, alternative := range entry.Alternatives {
+				known, err := normalizer.Normalize(alternative)
+				if err != nil {
+					return nil, err
+				}
+				if known.Match == ingredient_synonym.MatchCanonical || known.Match == ingredient_synonym.MatchSynonym {
+					normalized = known
+					break
+				}
+			}
+		}
+		ingredients = append(ingredients, Ingredient{
+			Position:      entry.Position,
+			Ingredient:    normalized.Name,
+			ListedAs:      entry.Name,
+			Concentration: entry.Concentration,
+			MayContain:    entry.MayContain,
+		})
+	}
+	return ingredients, nil
+}
+
+// writeIngredients replaces the parsed ingredient rows of a product.
+func writeIngredients(tx *sql.Tx, productID int, ingredients []Ingredient) error {
+	if _, err := tx.Exec("DELETE FROM Product_Ingredient WHERE Product_ID = ?", productID); err != nil {
+		return err
+	}
+	for _, ingredient := range ingredients {
+		_, err := tx.Exec(`
+    INSERT INTO Product_Ingredient (Product_ID, Position, Ingredient, Listed_As, Concentration, May_Contain)
+    VALUES (?, ?, ?, ?, ?, ?)`,
+			productID,
+			ingredient.Position,
+			ingredient.Ingredient,
+			ingredient.ListedAs,
+			ingredient.Concentration,
+			ingredient.MayContain)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get the parsed ingredient list of a product, in listed order
+func GetProductIngredients(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("products_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("products_id"))
+		return
+	}
+	ingredients, err := store.Ingredients(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if ingredients == nil {
+		ingredients = []Ingredient{}
+	}
+	c.JSON(http.StatusOK, gin.H{"data": ingredients})
+}
+
+// SyncAfterWrite reparses every product's ingredient list once a request
+// that changes the synonym table has succeeded, so the canonical names
+// follow it.
+func SyncAfterWrite(store Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if c.Request.Method == http.MethodGet || c.Writer.Status() >= 300 {
+			return
+		}
+		if err := store.SyncIngredients(); err != nil {
+			log.Printf("Reparsing product ingredients failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package products
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Database"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Most concerns, skin types or key ingredients one product may be linked to
+const maxLinks = 20
+
+// link describes one of the many-to-many tables between products and a
+// taxonomy. Each product also has a primary link, kept in the single column
+// on Products and listed first.
+type link struct {
+	table   string
+	column  string
+	field   string // JSON name of the primary ID
+	list    string // JSON name of the ID list
+	primary func(p *Product) *int
+	ids     func(p *Product) *[]int
+}
+
+var links = []link{
+	{"Product_Concern", "Concern_ID", "concern_id", "concern_ids",
+		func(p *Product) *int { return &p.ConcernID }, func(p *Product) *[]int { return &p.ConcernIDs }},
+	{"Product_Skin_Type", "Skin_Type_ID", "skin_type_id", "skin_type_ids",
+		func(p *Product) *int { return &p.SkinTypeID }, func(p *Product) *[]int { return &p.SkinTypeIDs }},
+	{"Product_Key_Ingredient", "Key_Ingredients_ID", "key_ingredients_id", "key_ingredients_ids",
+		func(p *Product) *int { return &p.KeyIngredientsID }, func(p *Product) *[]int { return &p.KeyIngredientIDs }},
+}
+
+// LinkExpectations lists the link tables and their columns, for the schema
+// check.
+func LinkExpectations() []database.Expectation {
+	var expectations []database.Expectation
+	for _, l := range links {
+		expectations = append(expectations, database.Expectation{Table: l.table, Columns: []string{"Product_ID", l.column}})
+	}
+	return expectations
+}
+
+// reconcileLinks makes product's primary IDs and ID lists agree after a
+// write that may have sent either. stored is the product before the write,
+// or the zero Product for a create or replacement.
+//
+//   - If only a primary ID changed, it takes the old primary's place in the
+//     list, so clients that only know the single fields keep working.
+//   - If only a list changed, its first ID becomes the primary unless the
+//     primary is still listed.
+//   - If both changed, the primary must be one of the listed IDs.
+//
+// The primary always ends up first in its list and duplicates are dropped.
+func reconcileLinks(stored Product, product *Product) []api_error.FieldError {
+	var fields []api_error.FieldError
+	for _, l := range links {
+		primary, ids := l.primary(product), l.ids(product)
+		oldPrimary, oldIDs := *l.primary(&stored), *l.ids(&stored)
+		*ids = distinct(*ids)
+		listChanged := !equalIDs(oldIDs, *ids)
+		switch {
+		case !listChanged && *primary != oldPrimary:
+			*ids = append([]int{*primary}, without(*ids, oldPrimary)...)
+		case listChanged && *primary == oldPrimary && !contains(*ids, *primary):
+			*primary = 0
+			if len(*ids) > 0 {
+				*primary = (*ids)[0]
+			}
+		case listChanged && len(*ids) > 0 && !contains(*ids, *primary):
+			fields = append(fields, api_error.FieldError{
+				Field:   l.field,
+				Code:    api_error.Invalid,
+				Message: fmt.Sprintf("%s must be one of %s", l.field, l.list),
+			})
+			continue
+		}
+		if *primary > 0 {
+			*ids = distinct(append([]int{*primary}, *ids...))
+		}
+	}
+	return fields
+}
+
+// copyLinks gives product ID lists of its own, so decoding a patch into it
+// cannot change the lists of the product it was copied from.
+func copyLinks(product Product) Product {
+	for _, l := range links {
+		if ids := l.ids(&product); *ids != nil {
+			*ids = append([]int{}, *ids...)
+		}
+	}
+	return product
+}
+
+// writeLinks replaces the link rows of a product.
+func writeLinks(tx *sql.Tx, productID int, product Product) error {
+	for _, l := range links {
+		if _, err := tx.Exec("DELETE FROM "+l.table+" WHERE Product_ID = ?", productID); err != nil {
+			return err
+		}
+		for _, id := range *l.ids(&product) {
+			_, err := tx.Exec("INSERT INTO "+l.table+" (Product_ID, "+l.column+") VALUES (?, ?)", productID, id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loadLinks fills in the ID lists of products, primary first, and their
+// badges.
+func (s *SQLStore) loadLinks(products []*Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+	byID := make(map[int]*Product, len(products))
+	args := make([]interface{}, 0, len(products))
+	for _, product := range products {
+		byID[product.ProductID] = product
+		args = append(args, product.ProductID)
+	}
+	in := "(?" + strings.Repeat(", ?", len(args)-1) + ")"
+	for _, l := range links {
+		for _, product := range products {
+			*l.ids(product) = []int{}
+		}
+		rows, err := s.db.Query("SELECT Product_ID, "+l.column+" FROM "+l.table+
+			" WHERE Product_ID IN "+in+" ORDER BY Product_ID, "+l.column, args...)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var productID, id int
+			if err := rows.Scan(&productID, &id); err != nil {
+				rows.Close()
+				return err
+			}
+			ids := l.ids(byID[productID])
+			*ids = append(*ids, id)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return err
+		}
+		for _, product := range products {
+			if primary := *l.primary(product); contains(*l.ids(product), primary) {
+				*l.ids(product) = append([]int{primary}, without(*l.ids(product), primary)...)
+			}
+		}
+	}
+	return s.loadBadges(products)
+}
+
+// distinct drops repeated IDs, keeping the first of each.
+func distinct(ids []int) []int {
+	if ids == nil {
+		return nil
+	}
+	seen := make(map[int]bool, len(ids))
+	kept := []int{}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
+
+// equalIDs compares two ID lists as sets.
+func equalIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]int{}, a...), append([]int{}, b...)
+	sort.Ints(a)
+	sort.Ints(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(ids []int, id int) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+func without(ids []int, id int) []int {
+	kept := []int{}
+	for _, candidate := range ids {
+		if candidate != id {
+			kept = append(kept, candidate)
+		}
+	}
+	return kept
+}
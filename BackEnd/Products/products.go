@@ -1,305 +1,333 @@
 package products
 
 import (
+	"BackEnd/Api_Error"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Request"
 	"database/sql"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
+// Product is one catalog entry. A product may be linked to several
+// concerns, skin types and key ingredients; ConcernID, SkinTypeID and
+// KeyIngredientsID are its primary links, which also lead their ID lists,
+// and the names are those of the primary links.
 type Product struct {
 	ProductID        int    `json:"product_id"`
 	ProductName      string `json:"product_name"`
 	AllIngredients   string `json:"all_ingredients"`
 	ProductURL       string `json:"product_url"`
 	ConcernID        int    `json:"concern_id"`
+	ConcernIDs       []int  `json:"concern_ids"`
 	Concern          string `json:"concern"`
 	SkinTypeID       int    `json:"skin_type_id"`
+	SkinTypeIDs      []int  `json:"skin_type_ids"`
 	SkinType         string `json:"skin_type"`
 	BrandID          int    `json:"brand_id"`
 	Brand            string `json:"brand"`
 	ProductTypeID    int    `json:"product_type_id"`
 	KeyIngredientsID int    `json:"key_ingredients_id"`
+	KeyIngredientIDs []int  `json:"key_ingredients_ids"`
 	KeyIngredients   string `json:"key_ingredients"`
 	ImageURL         string `json:"image_url"`
+	// Badges are derived from the ingredient attribute table on every read,
+	// such as fragrance-free; a product without a parsed ingredient list has
+	// none.
+	Badges []string `json:"badges"`
+	// MatchedViaWildcard is set by the selection endpoints on products found
+	// through a wildcard concern or skin type.
+	MatchedViaWildcard bool `json:"matched_via_wildcard,omitempty"`
 }
 
-// Get all products
-func GetProducts(c *gin.Context, db *sql.DB) {
-	rows, err := db.Query(`SELECT * FROM Products`)
+// Get products. product_id, concern_id, skin_type_id, brand_id,
+// product_type_id and key_ingredients_id each take several IDs, matching any
+// of a product's links, and combine with a name substring and the ingredient
+// filters; sort takes one of SortKeys, prefixed with - for descending order,
+// and fields limits each product to the named fields. With limit or cursor
+// the answer is one page wrapped in data and meta; without either it is the
+// bare array of every match, as before paging existed.
+func GetProducts(c *gin.Context, store Store, normalizer *ingredient_synonym.Normalizer) {
+	page, err := request.PageParams(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	defer rows.Close()
-
-	var products []Product
-	for rows.Next() {
-		var product Product
-		if err := rows.Scan(&product.ProductID, &product.ProductName, &product.AllIngredients, &product.ProductURL, &product.ConcernID,
-			&product.SkinTypeID, &product.BrandID, &product.ProductTypeID, &product.KeyIngredientsID, &product.ImageURL); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		products = append(products, product)
+	paged := request.Paged(c)
+	if !paged {
+		page = request.Page{}
+	}
+	query, err := pageQuery(c, page)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	query.Filter, err = parseFilter(c, normalizer)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	fields, err := request.Fields(c, Product{})
+	if err != nil {
+		api_error.Respond(c, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, products)
+	products, total, err := store.Page(query)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if products == nil {
+		products = []Product{}
+	}
+	data, err := request.SelectFields(products, fields)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if !paged {
+		c.JSON(http.StatusOK, data)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": data, "meta": page.Meta(total)})
 }
 
-// Get Select Products
-func GetSelectProducts(c *gin.Context, db *sql.DB, concernID, skinTypeID int) {
-	rows, err := db.Query(`
-    SELECT 
-        p.Product_Name,
-        p.All_Ingredients,
-        p.Product_URL,
-        b.Brand,
-        c.Concern,
-        k.Key_Ingredients,
-        s.Skin_Type,
-        p.Image_URL
-    FROM Products p
-    INNER JOIN Brand b ON p.Brand_ID = b.Brand_ID
-    INNER JOIN Concern c ON p.Concern_ID = c.Concern_ID
-    INNER JOIN Key_Ingredients k ON p.Key_Ingredients_ID = k.Key_Ingredients_ID
-    INNER JOIN Skin_Type s ON p.Skin_Type_ID = s.Skin_Type_ID
-    WHERE p.Concern_ID = ? 
-    AND p.Skin_Type_ID = ?
-    ORDER BY p.PRODUCT_TYPE_ID
-    `, concernID, skinTypeID)
-
+// Get Select Products, optionally narrowed by include_ingredient,
+// exclude_ingredient and min_concentration. Products for a wildcard concern
+// or skin type are included unless wildcards=false.
+func GetSelectProducts(c *gin.Context, store Store, normalizer *ingredient_synonym.Normalizer, concernID, skinTypeID int) {
+	options, err := ParseSelectOptions(c, normalizer)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	defer rows.Close()
-
-	var products []Product
-	for rows.Next() {
-		var product Product
-		if err := rows.Scan(
-			&product.ProductName,
-			&product.AllIngredients,
-			&product.ProductURL,
-			&product.Brand,
-			&product.Concern,
-			&product.KeyIngredients,
-			&product.SkinType,
-			&product.ImageURL,
-		); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		products = append(products, product)
+	products, err := store.Select(concernID, skinTypeID, options)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
 	}
 	c.JSON(http.StatusOK, products)
 }
 
-// Get Select Products of specific type
-func GetSelectProductsByType(c *gin.Context, db *sql.DB, concernID, skinTypeID, productTypeID int) {
-	rows, err := db.Query(`
-    SELECT 
-        p.Product_Name,
-        p.All_Ingredients,
-        p.Product_URL,
-        b.Brand,
-        c.Concern,
-        k.Key_Ingredients,
-        s.Skin_Type,
-        p.Image_URL
-    FROM Products p
-    INNER JOIN Brand b ON p.Brand_ID = b.Brand_ID
-    INNER JOIN Concern c ON p.Concern_ID = c.Concern_ID
-    INNER JOIN Key_Ingredients k ON p.Key_Ingredients_ID = k.Key_Ingredients_ID
-    INNER JOIN Skin_Type s ON p.Skin_Type_ID = s.Skin_Type_ID
-    WHERE p.Concern_ID = ? 
-    AND p.Skin_Type_ID = ?
-    AND p.Product_Type_ID = ?
-    ORDER BY p.PRODUCT_TYPE_ID
-    `, concernID, skinTypeID, productTypeID)
-
+// Get Select Products of specific type, with the same options
+func GetSelectProductsByType(c *gin.Context, store Store, normalizer *ingredient_synonym.Normalizer, concernID, skinTypeID, productTypeID int) {
+	options, err := ParseSelectOptions(c, normalizer)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	defer rows.Close()
-	var products []Product
-	for rows.Next() {
-		var product Product
-		if err := rows.Scan(
-			&product.ProductName,
-			&product.AllIngredients,
-			&product.ProductURL,
-			&product.Brand,
-			&product.Concern,
-			&product.KeyIngredients,
-			&product.SkinType,
-			&product.ImageURL,
-		); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		products = append(products, product)
+		api_error.Respond(c, err)
+		return
+	}
+	products, err := store.SelectByType(concernID, skinTypeID, productTypeID, options)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
 	}
 	c.JSON(http.StatusOK, products)
 }
 
-// Create a new product
-func CreateProduct(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	productIDStr := c.Query("product_id")
-	productName := c.Query("product_name")
-	allIngredients := c.Query("all_ingredients")
-	concernIDStr := c.Query("concern_id")
-	skinTypeIDStr := c.Query("skin_type_id")
-	brandIDStr := c.Query("brand_id")
-	productTypeIDStr := c.Query("product_type_id")
-	keyIngredientsIDStr := c.Query("key_ingredients_id")
-	// Convert string IDs to integers
-	productID, err := strconv.Atoi(productIDStr)
+// Get a product by ID
+func GetProduct(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("products_id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		api_error.Respond(c, api_error.InvalidParam("products_id"))
 		return
 	}
-	concernID, err := strconv.Atoi(concernIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid concern_id"})
+	product, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
 		return
 	}
-	skinTypeID, err := strconv.Atoi(skinTypeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid skin_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	brandID, err := strconv.Atoi(brandIDStr)
+	c.JSON(http.StatusOK, product)
+}
+
+// Create a new product
+func CreateProduct(c *gin.Context, store Store, refs References) {
+	newProduct, err := bindProduct(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brand_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	productTypeID, err := strconv.Atoi(productTypeIDStr)
+	// Validate before writing
+	linkFields := reconcileLinks(Product{}, &newProduct)
+	fields, err := Validate(newProduct, refs)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	keyIngredientsID, err := strconv.Atoi(keyIngredientsIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid key_ingredients_id"})
-		return
-	}
-	// Prepare the new product struct
-	newProduct := Product{
-		ProductID:        productID,
-		ProductName:      productName,
-		AllIngredients:   allIngredients,
-		ConcernID:        concernID,
-		SkinTypeID:       skinTypeID,
-		BrandID:          brandID,
-		ProductTypeID:    productTypeID,
-		KeyIngredientsID: keyIngredientsID,
-	}
-	// Execute the SQL statement with parameters
-	result, err := db.Exec(`
-    INSERT INTO Products (Product_ID, Product_Name, All_Ingredients, Concern_ID, Skin_Type_ID, Brand_ID, Product_Type_ID, Key_Ingredients_ID)
-    VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
-		newProduct.ProductID,
-		newProduct.ProductName,
-		newProduct.AllIngredients,
-		newProduct.ConcernID,
-		newProduct.SkinTypeID,
-		newProduct.BrandID,
-		newProduct.ProductTypeID,
-		newProduct.KeyIngredientsID)
+	fields = append(linkFields, fields...)
+	if fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the product
+	newProduct, err = store.Create(newProduct)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Retrieve and set the last inserted ID if needed
-	id, _ := result.LastInsertId()
-	newProduct.ProductID = int(id)
-	// Send the response
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/products/%d", newProduct.ProductID))
 	c.JSON(http.StatusCreated, newProduct)
 }
 
-// Update a product
-func UpdateProduct(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	productIDStr := c.Query("product_id")
-	productName := c.Query("product_name")
-	allIngredients := c.Query("all_ingredients")
-	concernIDStr := c.Query("concern_id")
-	skinTypeIDStr := c.Query("skin_type_id")
-	brandIDStr := c.Query("brand_id")
-	productTypeIDStr := c.Query("product_type_id")
-	keyIngredientsIDStr := c.Query("key_ingredients_id")
-	// Convert string IDs to integers
-	productID, err := strconv.Atoi(productIDStr)
+// Update a product, replacing every field
+func UpdateProduct(c *gin.Context, store Store, refs References) {
+	updatedProduct, err := bindProduct(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	concernID, err := strconv.Atoi(concernIDStr)
+	// Validate before writing
+	linkFields := reconcileLinks(Product{}, &updatedProduct)
+	fields, err := ValidateReplacement(updatedProduct, refs)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid concern_id"})
+		api_error.Respond(c, err)
+		return
+	}
+	fields = append(linkFields, fields...)
+	if fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedProduct)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedProduct.ProductID))
 		return
 	}
-	skinTypeID, err := strconv.Atoi(skinTypeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid skin_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	brandID, err := strconv.Atoi(brandIDStr)
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of a product
+func PatchProduct(c *gin.Context, store Store, refs References) {
+	id, err := strconv.Atoi(c.Param("products_id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brand_id"})
+		api_error.Respond(c, api_error.InvalidParam("products_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
 		return
 	}
-	productTypeID, err := strconv.Atoi(productTypeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	keyIngredientsID, err := strconv.Atoi(keyIngredientsIDStr)
+	before := copyLinks(patched)
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("product_id", patched.ProductID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	linkFields := reconcileLinks(before, &patched)
+	fields, err := Validate(patched, refs)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid key_ingredients_id"})
-		return
-	}
-	// Prepare the updated product struct
-	updatedProduct := Product{
-		ProductID:        productID,
-		ProductName:      productName,
-		AllIngredients:   allIngredients,
-		ConcernID:        concernID,
-		SkinTypeID:       skinTypeID,
-		BrandID:          brandID,
-		ProductTypeID:    productTypeID,
-		KeyIngredientsID: keyIngredientsID,
-	}
-	// Execute the SQL statement with parameters
-	_, err = db.Exec(`
-    UPDATE PRODUCTS SET 
-        Product_Name = ?, 
-        All_Ingredients = ?, 
-        Concern_ID = ?, 
-        Skin_Type_ID = ?,
-        Brand_ID = ?, 
-        Product_Type_ID = ?, 
-        Key_Ingredients_ID = ? 
-    WHERE Product_ID = ?`)
+		api_error.Respond(c, err)
+		return
+	}
+	fields = append(linkFields, fields...)
+	if fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Send the response
-	c.JSON(http.StatusOK, updatedProduct)
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
 }
 
 // Delete a product
-func DeleteProduct(c *gin.Context, db *sql.DB) {
-	id := c.Param("products_id")
-	_, err := db.Exec("DELETE FROM PRODUCTS WHERE Product_ID = ?", id)
+func DeleteProduct(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("products_id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, api_error.InvalidParam("products_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Product deleted"})
 }
+
+// pageQuery combines the page with the sort query parameter.
+func pageQuery(c *gin.Context, page request.Page) (PageQuery, error) {
+	query := PageQuery{Offset: page.Offset, Limit: page.Limit}
+	sortKey := c.Query("sort")
+	if strings.HasPrefix(sortKey, "-") {
+		query.Descending = true
+		sortKey = sortKey[1:]
+	}
+	if sortKey == "" && !query.Descending {
+		return query, nil
+	}
+	for _, key := range SortKeys {
+		if key == sortKey {
+			query.Sort = key
+			return query, nil
+		}
+	}
+	return PageQuery{}, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+		Field:   "sort",
+		Code:    api_error.Invalid,
+		Message: "sort must be one of " + strings.Join(SortKeys, ", ") + ", optionally prefixed with -",
+	})
+}
+
+// bindProduct reads a product from the JSON request body. Requests without
+// one fall back to the deprecated query parameters, which cannot carry the
+// product and image URLs.
+func bindProduct(c *gin.Context) (Product, error) {
+	var product Product
+	if request.HasJSONBody(c) {
+		err := request.BindJSON(c, &product)
+		return product, err
+	}
+	request.DeprecatedQuery(c)
+	product.ProductName = c.Query("product_name")
+	product.AllIngredients = c.Query("all_ingredients")
+	// Convert string IDs to integers
+	err := request.QueryInts(c,
+		request.IntParam{Name: "product_id", Dest: &product.ProductID, Optional: true},
+		request.IntParam{Name: "concern_id", Dest: &product.ConcernID},
+		request.IntParam{Name: "skin_type_id", Dest: &product.SkinTypeID},
+		request.IntParam{Name: "brand_id", Dest: &product.BrandID},
+		request.IntParam{Name: "product_type_id", Dest: &product.ProductTypeID},
+		request.IntParam{Name: "key_ingredients_id", Dest: &product.KeyIngredientsID},
+	)
+	return product, err
+}
+
+// notFound reports an unknown Product_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Product %d not found", id))
+}
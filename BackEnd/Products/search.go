@@ -0,0 +1,200 @@
+package products
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"html"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// Limits on search queries
+const (
+	maxQueryLength = 200
+	maxTerms       = 10
+	snippetWidth   = 120
+)
+
+// Column weights when ranking search matches: a hit in the product name
+// counts for more than one in the ingredient list.
+const (
+	nameWeight          = 4
+	brandWeight         = 2
+	keyIngredientWeight = 2
+	ingredientsWeight   = 1
+)
+
+// SearchQuery selects one page of the products matching any of Terms.
+type SearchQuery struct {
+	Terms  []string
+	Offset int
+	Limit  int
+}
+
+// Match is a product found by a search, with its relevance. Higher scores
+// are better; they are only comparable within one search.
+type Match struct {
+	Product Product
+	Score   float64
+}
+
+// SearchResult is one product in the response of GET /products/search.
+// Highlights holds an HTML fragment for each field that matched, with the
+// matching words wrapped in <mark> tags.
+type SearchResult struct {
+	Product    Product           `json:"product"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights"`
+}
+
+// Search products by name, ingredient list, brand and key ingredient. Words
+// in q match any word they start, and products matching more of them rank
+// higher.
+func SearchProducts(c *gin.Context, store Store) {
+	q := c.Query("q")
+	terms := searchTerms(q)
+	if len(q) > maxQueryLength || len(terms) == 0 {
+		api_error.Respond(c, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+			Field:   "q",
+			Code:    api_error.Invalid,
+			Message: fmt.Sprintf("q must contain a word to search for and be at most %d characters", maxQueryLength),
+		}))
+		return
+	}
+	page, err := request.PageParams(c)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+
+	matches, total, err := store.Search(SearchQuery{Terms: terms, Offset: page.Offset, Limit: page.Limit})
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	results := []SearchResult{}
+	for _, match := range matches {
+		results = append(results, SearchResult{
+			Product:    match.Product,
+			Score:      match.Score,
+			Highlights: highlights(match.Product, terms),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"data": results, "meta": page.Meta(total)})
+}
+
+// searchTerms splits a query into distinct lower-case words. Punctuation is
+// dropped, so the terms are safe to place in a full-text query.
+func searchTerms(q string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(q), notWordRune) {
+		if !seen[word] && len(terms) < maxTerms {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+func notWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// highlights marks the search terms in each field of product that has them.
+func highlights(product Product, terms []string) map[string]string {
+	fields := map[string]string{
+		"product_name":    highlight(product.ProductName, terms, 0),
+		"all_ingredients": highlight(product.AllIngredients, terms, snippetWidth),
+		"brand":           highlight(product.Brand, terms, 0),
+		"key_ingredients": highlight(product.KeyIngredients, terms, 0),
+	}
+	for field, fragment := range fields {
+		if fragment == "" {
+			delete(fields, field)
+		}
+	}
+	return fields
+}
+
+// highlight HTML-escapes text and wraps every word starting with one of terms
+// in <mark> tags. A positive width cuts the text to about that many runes
+// around the first match. It returns "" when no word matches.
+func highlight(text string, terms []string, width int) string {
+	runes := []rune(text)
+	type span struct{ start, end int }
+	var marks []span
+	for start := 0; start < len(runes); {
+		if notWordRune(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && !notWordRune(runes[end]) {
+			end++
+		}
+		if matchesTerm(string(runes[start:end]), terms) {
+			marks = append(marks, span{start, end})
+		}
+		start = end
+	}
+	if marks == nil {
+		return ""
+	}
+
+	from, to := 0, len(runes)
+	if width > 0 && len(runes) > width {
+		from = marks[0].start - width/3
+		if from < 0 {
+			from = 0
+		}
+		to = from + width
+		if to > len(runes) {
+			to, from = len(runes), len(runes)-width
+		}
+	}
+
+	var fragment strings.Builder
+	if from > 0 {
+		fragment.WriteString("…")
+	}
+	at := from
+	for _, mark := range marks {
+		if mark.start < from || mark.end > to {
+			continue
+		}
+		fragment.WriteString(html.EscapeString(string(runes[at:mark.start])))
+		fragment.WriteString("<mark>" + html.EscapeString(string(runes[mark.start:mark.end])) + "</mark>")
+		at = mark.end
+	}
+	fragment.WriteString(html.EscapeString(string(runes[at:to])))
+	if to < len(runes) {
+		fragment.WriteString("…")
+	}
+	return fragment.String()
+}
+
+// matchesTerm reports whether word starts with one of terms, ignoring case.
+func matchesTerm(word string, terms []string) bool {
+	word = strings.ToLower(word)
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// termScore counts the words of text that start with one of terms.
+func termScore(text string, terms []string) float64 {
+	var score float64
+	for _, word := range strings.FieldsFunc(text, notWordRune) {
+		if matchesTerm(word, terms) {
+			score++
+		}
+	}
+	return score
+}
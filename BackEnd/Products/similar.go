@@ -0,0 +1,253 @@
+package products
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Key_Ingredients"
+	"BackEnd/Request"
+	"database/sql"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Shares of the similarity score: how much the ingredient lists overlap,
+// whether the product type is the same and whether a concern is shared
+const (
+	ingredientShare = 0.7
+	typeShare       = 0.15
+	concernShare    = 0.15
+)
+
+// An active ingredient, one with a listed concentration or named like a key
+// ingredient, counts this many times as much as another at its position
+const activeWeight = 2
+
+// Similar is a product like another one, with how alike they are. Score runs
+// from 0 to 1; IngredientSimilarity is the ingredient part of it on its own.
+// The ingredient lists are canonical names in listed order.
+type Similar struct {
+	Product
+	Score                float64  `json:"score"`
+	IngredientSimilarity float64  `json:"ingredient_similarity"`
+	SameProductType      bool     `json:"same_product_type"`
+	SharedConcern        bool     `json:"shared_concern"`
+	SharedIngredients    []string `json:"shared_ingredients"`
+	OnlyInOriginal       []string `json:"only_in_original"`
+	OnlyInSimilar        []string `json:"only_in_similar"`
+}
+
+// Get the products most like a product, best first. Ingredient lists are
+// compared with each ingredient weighted by how early it is listed and
+// whether it is an active, and products of the same type or for a shared
+// concern rank higher. Only products of the same type, for a shared concern
+// or with an active in common are scored, and those sharing no ingredients
+// are left out.
+func GetSimilarProducts(c *gin.Context, store Store, keyIngredients key_ingredients.Store, normalizer *ingredient_synonym.Normalizer) {
+	id, err := strconv.Atoi(c.Param("products_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("products_id"))
+		return
+	}
+	limit := request.DefaultLimit
+	if err := request.QueryInts(c, request.IntParam{Name: "limit", Dest: &limit, Optional: true}); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if limit < 1 || limit > request.MaxLimit {
+		api_error.Respond(c, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+			Field:   "limit",
+			Code:    api_error.Invalid,
+			Message: fmt.Sprintf("limit must be between 1 and %d", request.MaxLimit),
+		}))
+		return
+	}
+	original, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+
+	catalog, err := store.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	lists, err := store.IngredientLists()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	actives, err := activeNames(keyIngredients, normalizer)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	weighted := weighIngredients(lists[original.ProductID], actives)
+	originalActives := activesOf(lists[original.ProductID], actives)
+
+	var ranked []Similar
+	for _, product := range catalog {
+		if product.ProductID == original.ProductID {
+			continue
+		}
+		sameType := product.ProductTypeID == original.ProductTypeID
+		sharedConcern := sharesAny(product.ConcernIDs, original.ConcernIDs)
+		// Only products alike in type, concern or an active are scored
+		if !sameType && !sharedConcern && !sharesActive(lists[product.ProductID], actives, originalActives) {
+			continue
+		}
+		similar := compareIngredients(weighted, weighIngredients(lists[product.ProductID], actives))
+		if len(similar.SharedIngredients) == 0 {
+			continue
+		}
+		similar.Product = product
+		similar.SameProductType = sameType
+		similar.SharedConcern = sharedConcern
+		similar.Score = ingredientShare * similar.IngredientSimilarity
+		if similar.SameProductType {
+			similar.Score += typeShare
+		}
+		if similar.SharedConcern {
+			similar.Score += concernShare
+		}
+		ranked = append(ranked, similar)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].ProductID < ranked[j].ProductID
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	data := make([]Similar, 0, len(ranked))
+	for _, similar := range ranked {
+		similar.Score = math.Round(similar.Score*1000) / 1000
+		similar.IngredientSimilarity = math.Round(similar.IngredientSimilarity*1000) / 1000
+		data = append(data, similar)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": data})
+}
+
+// weightedIngredient is an ingredient of a list with the weight it carries
+// when lists are compared.
+type weightedIngredient struct {
+	name   string
+	weight float64
+}
+
+// activeNames returns the folded canonical names of the key ingredients.
+func activeNames(store key_ingredients.Store, normalizer *ingredient_synonym.Normalizer) (map[string]bool, error) {
+	keyIngredients, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(keyIngredients))
+	for _, ingredient := range keyIngredients {
+		canonical, err := normalizer.Canonical(ingredient.KeyIngredient)
+		if err != nil {
+			return nil, err
+		}
+		names[strings.ToLower(canonical)] = true
+	}
+	return names, nil
+}
+
+// weighIngredients weighs each ingredient a product certainly contains by the
+// inverse square root of its position, so the first few dominate, counting
+// actives activeWeight times.
+func weighIngredients(ingredients []Ingredient, actives map[string]bool) []weightedIngredient {
+	var weighted []weightedIngredient
+	for i, ingredient := range certainIngredients(ingredients) {
+		weight := 1 / math.Sqrt(float64(i+1))
+		if isActive(ingredient, actives) {
+			weight *= activeWeight
+		}
+		weighted = append(weighted, weightedIngredient{name: ingredient.Ingredient, weight: weight})
+	}
+	return weighted
+}
+
+// isActive reports whether ingredient is an active: listed with a
+// concentration or named like a key ingredient.
+func isActive(ingredient Ingredient, actives map[string]bool) bool {
+	return ingredient.Concentration != nil || actives[strings.ToLower(ingredient.Ingredient)]
+}
+
+// activesOf returns the folded names of the actives a product certainly
+// contains.
+func activesOf(ingredients []Ingredient, actives map[string]bool) map[string]bool {
+	names := make(map[string]bool)
+	for _, ingredient := range certainIngredients(ingredients) {
+		if isActive(ingredient, actives) {
+			names[strings.ToLower(ingredient.Ingredient)] = true
+		}
+	}
+	return names
+}
+
+// sharesActive reports whether a product certainly contains one of wanted as
+// an active.
+func sharesActive(ingredients []Ingredient, actives, wanted map[string]bool) bool {
+	for name := range activesOf(ingredients, actives) {
+		if wanted[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// compareIngredients fills in the ingredient part of a comparison: the share
+// of the two lists' combined weight that falls on shared ingredients, and
+// which ingredients are shared or only in one list.
+func compareIngredients(original, other []weightedIngredient) Similar {
+	similar := Similar{SharedIngredients: []string{}, OnlyInOriginal: []string{}, OnlyInSimilar: []string{}}
+	inOther := make(map[string]float64, len(other))
+	for _, ingredient := range other {
+		inOther[strings.ToLower(ingredient.name)] = ingredient.weight
+	}
+	inOriginal := make(map[string]bool, len(original))
+	var shared, total float64
+	for _, ingredient := range original {
+		key := strings.ToLower(ingredient.name)
+		inOriginal[key] = true
+		total += ingredient.weight
+		if weight, ok := inOther[key]; ok {
+			shared += ingredient.weight + weight
+			similar.SharedIngredients = append(similar.SharedIngredients, ingredient.name)
+		} else {
+			similar.OnlyInOriginal = append(similar.OnlyInOriginal, ingredient.name)
+		}
+	}
+	for _, ingredient := range other {
+		total += ingredient.weight
+		if !inOriginal[strings.ToLower(ingredient.name)] {
+			similar.OnlyInSimilar = append(similar.OnlyInSimilar, ingredient.name)
+		}
+	}
+	if total > 0 {
+		similar.IngredientSimilarity = shared / total
+	}
+	return similar
+}
+
+// sharesAny reports whether the two ID lists have an ID in common.
+func sharesAny(a, b []int) bool {
+	for _, id := range a {
+		if contains(b, id) {
+			return true
+		}
+	}
+	return false
+}
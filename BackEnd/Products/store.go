@@ -0,0 +1,953 @@
+package products
+
+import (
+	"BackEnd/Brand"
+	"BackEnd/Concern"
+	"BackEnd/Database"
+	"BackEnd/Ingredient_Attribute"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Key_Ingredients"
+	"BackEnd/Skin_Type"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Store is the persistence boundary for products. Lookups, updates and deletes of
+// an unknown Product_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned.
+// Create and Update also store the product's concern, skin type and key
+// ingredient links and its parsed All_Ingredients list in the same
+// transaction, and every read fills in the link lists and badges.
+type Store interface {
+	List() ([]Product, error)
+	Get(id int) (Product, error)
+	Create(product Product) (Product, error)
+	Update(product Product) (Product, error)
+	Delete(id int) error
+	// Select returns products for a concern and skin type, with the brand,
+	// concern, key ingredient and skin type names filled in.
+	Select(concernID, skinTypeID int, options SelectOptions) ([]Product, error)
+	// SelectByType narrows Select to a single product type.
+	SelectByType(concernID, skinTypeID, productTypeID int, options SelectOptions) ([]Product, error)
+	// Page returns one page of the products passing query.Filter, with the
+	// taxonomy names filled in, and the number of matches across all pages.
+	Page(query PageQuery) ([]Product, int, error)
+	// Search returns one page of the products matching any of query.Terms,
+	// best first, with the names filled in, and the number of matches.
+	Search(query SearchQuery) ([]Match, int, error)
+	// Ingredients returns the parsed ingredient list of a product in listed
+	// order, or sql.ErrNoRows for an unknown product.
+	Ingredients(productID int) ([]Ingredient, error)
+	// IngredientLists returns the parsed ingredient list of every product,
+	// keyed by product ID.
+	IngredientLists() (map[int][]Ingredient, error)
+	// SyncIngredients reparses the ingredient list of every product, for
+	// when the parser or the synonym table has changed.
+	SyncIngredients() error
+}
+
+// SelectOptions adjusts Select and SelectByType.
+type SelectOptions struct {
+	Ingredients IngredientFilter
+	// Wildcards also selects products linked to a wildcard concern or skin
+	// type, such as Multipurpose or All Types, marking them
+	// MatchedViaWildcard.
+	Wildcards bool
+}
+
+// PageQuery filters, orders and selects one page of the catalog.
+type PageQuery struct {
+	Filter     Filter
+	Sort       string // one of SortKeys, or "" for product ID order
+	Descending bool
+	Offset     int
+	Limit      int // 0 selects every row from Offset on
+}
+
+// SortKeys are the orders GET /products accepts. Names compare without
+// regard to case; type follows the product type IDs, which run in routine
+// order. Ties fall back to the product ID.
+var SortKeys = []string{"name", "brand", "type"}
+
+// sortColumns maps each sort key to the expression SQLStore orders by.
+var sortColumns = map[string]string{
+	"":      "p.Product_ID",
+	"name":  "LOWER(p.Product_Name)",
+	"brand": "LOWER(b.Brand)",
+	"type":  "p.Product_Type_ID",
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Products"
+
+var Columns = []string{"Product_ID", "Product_Name", "All_Ingredients", "Product_URL", "Concern_ID",
+	"Skin_Type_ID", "Brand_ID", "Product_Type_ID", "Key_Ingredients_ID", "Image_URL"}
+
+// SQLStore keeps products in the Products table of a MySQL or SQLite database.
+type SQLStore struct {
+	db         *sql.DB
+	dialect    database.Dialect
+	normalizer *ingredient_synonym.Normalizer
+}
+
+// NewSQLStore needs the dialect for search, which MySQL and SQLite index
+// differently, and the normalizer that names parsed ingredients.
+func NewSQLStore(db *sql.DB, dialect database.Dialect, normalizer *ingredient_synonym.Normalizer) *SQLStore {
+	return &SQLStore{db: db, dialect: dialect, normalizer: normalizer}
+}
+
+func (s *SQLStore) List() ([]Product, error) {
+	rows, err := s.db.Query("SELECT " + namedColumns + " FROM Products p" + namedJoins + " ORDER BY p.Product_ID")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var products []Product
+	for rows.Next() {
+		product, err := scanNamedProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// Free the connection for loadLinks; SQLite has only the one
+	rows.Close()
+	return products, s.loadLinks(pointers(products))
+}
+
+func (s *SQLStore) Get(id int) (Product, error) {
+	product, err := scanNamedProduct(s.db.QueryRow("SELECT "+namedColumns+" FROM Products p"+namedJoins+" WHERE p.Product_ID = ?", id))
+	if err != nil {
+		return Product{}, err
+	}
+	return product, s.loadLinks([]*Product{&product})
+}
+
+// pointers lets loadLinks fill in a slice of products.
+func pointers(products []Product) []*Product {
+	pointers := make([]*Product, len(products))
+	for i := range products {
+		pointers[i] = &products[i]
+	}
+	return pointers
+}
+
+func (s *SQLStore) Create(product Product) (Product, error) {
+	// Parse before the transaction: the normalizer may need to read the
+	// synonyms, and SQLite has a single connection
+	ingredients, err := parseIngredients(product.AllIngredients, s.normalizer)
+	if err != nil {
+		return Product{}, err
+	}
+	var id int64
+	err = database.InTx(s.db, func(tx *sql.Tx) error {
+		result, err := tx.Exec(`
+    INSERT INTO Products (Product_Name, All_Ingredients, Product_URL, Concern_ID, Skin_Type_ID, Brand_ID, Product_Type_ID, Key_Ingredients_ID, Image_URL)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			product.ProductName,
+			product.AllIngredients,
+			product.ProductURL,
+			product.ConcernID,
+			product.SkinTypeID,
+			product.BrandID,
+			product.ProductTypeID,
+			product.KeyIngredientsID,
+			product.ImageURL)
+		if err != nil {
+			return err
+		}
+		// The ID is assigned by the database
+		id, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if err := writeLinks(tx, int(id), product); err != nil {
+			return err
+		}
+		return writeIngredients(tx, int(id), ingredients)
+	})
+	if err != nil {
+		return Product{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(product Product) (Product, error) {
+	ingredients, err := parseIngredients(product.AllIngredients, s.normalizer)
+	if err != nil {
+		return Product{}, err
+	}
+	err = database.InTx(s.db, func(tx *sql.Tx) error {
+		result, err := tx.Exec(`
+    UPDATE Products SET
+        Product_Name = ?,
+        All_Ingredients = ?,
+        Product_URL = ?,
+        Concern_ID = ?,
+        Skin_Type_ID = ?,
+        Brand_ID = ?,
+        Product_Type_ID = ?,
+        Key_Ingredients_ID = ?,
+        Image_URL = ?
+    WHERE Product_ID = ?`,
+			product.ProductName,
+			product.AllIngredients,
+			product.ProductURL,
+			product.ConcernID,
+			product.SkinTypeID,
+			product.BrandID,
+			product.ProductTypeID,
+			product.KeyIngredientsID,
+			product.ImageURL,
+			product.ProductID,
+		)
+		if err != nil {
+			return err
+		}
+		if err := database.RequireRow(result); err != nil {
+			return err
+		}
+		if err := writeLinks(tx, product.ProductID, product); err != nil {
+			return err
+		}
+		return writeIngredients(tx, product.ProductID, ingredients)
+	})
+	if err != nil {
+		return Product{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(product.ProductID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Products WHERE Product_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+func (s *SQLStore) Ingredients(productID int) ([]Ingredient, error) {
+	if _, err := s.Get(productID); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.Query(`
+    SELECT Position, Ingredient, Listed_As, Concentration, May_Contain
+    FROM Product_Ingredient
+    WHERE Product_ID = ?
+    ORDER BY Position`, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ingredients []Ingredient
+	for rows.Next() {
+		ingredient, err := scanIngredient(rows)
+		if err != nil {
+			return nil, err
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+	return ingredients, rows.Err()
+}
+
+func (s *SQLStore) IngredientLists() (map[int][]Ingredient, error) {
+	rows, err := s.db.Query(`
+    SELECT Product_ID, Position, Ingredient, Listed_As, Concentration, May_Contain
+    FROM Product_Ingredient
+    ORDER BY Product_ID, Position`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lists := make(map[int][]Ingredient)
+	for rows.Next() {
+		var productID int
+		ingredient, err := scanIngredient(rows, &productID)
+		if err != nil {
+			return nil, err
+		}
+		lists[productID] = append(lists[productID], ingredient)
+	}
+	return lists, rows.Err()
+}
+
+// scanIngredient reads one Product_Ingredient row, after any leading columns
+// read into leading.
+func scanIngredient(rows *sql.Rows, leading ...interface{}) (Ingredient, error) {
+	var ingredient Ingredient
+	var concentration sql.NullFloat64
+	dest := append(leading, &ingredient.Position, &ingredient.Ingredient, &ingredient.ListedAs, &concentration, &ingredient.MayContain)
+	if err := rows.Scan(dest...); err != nil {
+		return Ingredient{}, err
+	}
+	if concentration.Valid {
+		ingredient.Concentration = &concentration.Float64
+	}
+	return ingredient, nil
+}
+
+func (s *SQLStore) SyncIngredients() error {
+	products, err := s.List()
+	if err != nil {
+		return err
+	}
+	parsed := make(map[int][]Ingredient, len(products))
+	for _, product := range products {
+		ingredients, err := parseIngredients(product.AllIngredients, s.normalizer)
+		if err != nil {
+			return err
+		}
+		parsed[product.ProductID] = ingredients
+	}
+	return database.InTx(s.db, func(tx *sql.Tx) error {
+		for id, ingredients := range parsed {
+			if err := writeIngredients(tx, id, ingredients); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *SQLStore) Select(concernID, skinTypeID int, options SelectOptions) ([]Product, error) {
+	return s.selectLinked(concernID, skinTypeID, nil, options)
+}
+
+func (s *SQLStore) SelectByType(concernID, skinTypeID, productTypeID int, options SelectOptions) ([]Product, error) {
+	return s.selectLinked(concernID, skinTypeID, &productTypeID, options)
+}
+
+// selectLinked finds the products linked to a concern and a skin type,
+// either directly or, with options.Wildcards, through a wildcard one. Each
+// product is named after the concern and skin type it was found through,
+// preferring a direct link, and products found directly come first within
+// each product type. The product's concern and skin type IDs are the ones it
+// was found through.
+func (s *SQLStore) selectLinked(concernID, skinTypeID int, productTypeID *int, options SelectOptions) ([]Product, error) {
+	conditions, args := options.Ingredients.conditions()
+	if productTypeID != nil {
+		conditions = append([]string{"p.Product_Type_ID = ?"}, conditions...)
+		args = append([]interface{}{*productTypeID}, args...)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, "\n    AND ")
+	}
+	return s.query(`
+    SELECT `+namedColumns+`,
+        c.Concern_ID,
+        s.Skin_Type_ID,
+        (c.Concern_ID <> ? OR s.Skin_Type_ID <> ?) AS Matched_Via_Wildcard
+    FROM Products p
+    INNER JOIN Brand b ON p.Brand_ID = b.Brand_ID
+    INNER JOIN Key_Ingredients k ON p.Key_Ingredients_ID = k.Key_Ingredients_ID
+    INNER JOIN Concern c ON c.Concern_ID = (
+        SELECT pc.Concern_ID FROM Product_Concern pc
+        INNER JOIN Concern linked ON linked.Concern_ID = pc.Concern_ID
+        WHERE pc.Product_ID = p.Product_ID AND (pc.Concern_ID = ? OR (? AND linked.Is_Wildcard))
+        ORDER BY pc.Concern_ID = ? DESC, pc.Concern_ID
+        LIMIT 1)
+    INNER JOIN Skin_Type s ON s.Skin_Type_ID = (
+        SELECT ps.Skin_Type_ID FROM Product_Skin_Type ps
+        INNER JOIN Skin_Type linked ON linked.Skin_Type_ID = ps.Skin_Type_ID
+        WHERE ps.Product_ID = p.Product_ID AND (ps.Skin_Type_ID = ? OR (? AND linked.Is_Wildcard))
+        ORDER BY ps.Skin_Type_ID = ? DESC, ps.Skin_Type_ID
+        LIMIT 1)
+    `+where+`
+    ORDER BY p.PRODUCT_TYPE_ID, Matched_Via_Wildcard, p.Product_ID
+    `, append([]interface{}{
+		concernID, skinTypeID,
+		concernID, options.Wildcards, concernID,
+		skinTypeID, options.Wildcards, skinTypeID,
+	}, args...)...)
+}
+
+func (s *SQLStore) Page(query PageQuery) ([]Product, int, error) {
+	where, args := query.Filter.where()
+	var total int
+	if err := s.db.QueryRow("SELECT COUNT(*) FROM Products p "+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+	limit := ""
+	if query.Limit > 0 {
+		limit = "LIMIT ? OFFSET ?"
+		args = append(args, query.Limit, query.Offset)
+	}
+	rows, err := s.db.Query(`
+    SELECT `+namedColumns+`
+    FROM Products p`+namedJoins+`
+    `+where+`
+    ORDER BY `+sortColumns[query.Sort]+` `+direction+`, p.Product_ID
+    `+limit, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var products []Product
+	for rows.Next() {
+		product, err := scanNamedProduct(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	// Free the connection for loadLinks; SQLite has only the one
+	rows.Close()
+	return products, total, s.loadLinks(pointers(products))
+}
+
+func (s *SQLStore) Search(query SearchQuery) ([]Match, int, error) {
+	var count, search string
+	var args []interface{}
+	switch s.dialect {
+	case database.MySQL:
+		// Each column has its own FULLTEXT index; boolean mode lets term* match
+		// longer words, and a product matching more terms scores higher. Every
+		// key ingredient the product is linked to counts, not only the primary.
+		against := strings.Join(query.Terms, "* ") + "*"
+		scored := fmt.Sprintf(`
+        SELECT %s,
+            %d * MATCH(p.Product_Name) AGAINST(? IN BOOLEAN MODE)
+            + %d * COALESCE(MATCH(b.Brand) AGAINST(? IN BOOLEAN MODE), 0)
+            + %d * COALESCE((SELECT SUM(MATCH(lk.Key_Ingredients) AGAINST(? IN BOOLEAN MODE))
+                FROM Product_Key_Ingredient pk
+                JOIN Key_Ingredients lk ON pk.Key_Ingredients_ID = lk.Key_Ingredients_ID
+                WHERE pk.Product_ID = p.Product_ID), 0)
+            + %d * MATCH(p.All_Ingredients) AGAINST(? IN BOOLEAN MODE) AS Score
+        FROM Products p%s`, namedColumns, nameWeight, brandWeight, keyIngredientWeight, ingredientsWeight, namedJoins)
+		count = "SELECT COUNT(*) FROM (" + scored + ") ranked WHERE Score > 0"
+		search = "SELECT * FROM (" + scored + ") ranked WHERE Score > 0 ORDER BY Score DESC, Product_ID LIMIT ? OFFSET ?"
+		args = []interface{}{against, against, against, against}
+	case database.SQLite:
+		// Product_Search is an FTS5 table keyed by Product_ID; bm25 is lower
+		// for better matches, so it is negated into a score.
+		var match []string
+		for _, term := range query.Terms {
+			match = append(match, `"`+term+`"*`)
+		}
+		count = "SELECT COUNT(*) FROM Product_Search WHERE Product_Search MATCH ?"
+		search = fmt.Sprintf(`
+        SELECT %s, -bm25(Product_Search, %d, %d, %d, %d) AS Score
+        FROM Product_Search
+        JOIN Products p ON p.Product_ID = Product_Search.rowid%s
+        WHERE Product_Search MATCH ?
+        ORDER BY Score DESC, p.Product_ID
+        LIMIT ? OFFSET ?`, namedColumns, nameWeight, ingredientsWeight, brandWeight, keyIngredientWeight, namedJoins)
+		args = []interface{}{strings.Join(match, " OR ")}
+	default:
+		return nil, 0, fmt.Errorf("unknown dialect %q", s.dialect)
+	}
+
+	var total int
+	if err := s.db.QueryRow(count, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := s.db.Query(search, append(args, query.Limit, query.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var matches []Match
+	for rows.Next() {
+		var match Match
+		if match.Product, err = scanNamedProduct(rows, &match.Score); err != nil {
+			return nil, 0, err
+		}
+		matches = append(matches, match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	rows.Close()
+	found := make([]*Product, len(matches))
+	for i := range matches {
+		found[i] = &matches[i].Product
+	}
+	return matches, total, s.loadLinks(found)
+}
+
+// namedColumns and namedJoins select a product with the taxonomy names
+// filled in, in the order scanNamedProduct reads. Products pointing at a
+// missing row are kept, with an empty name.
+var namedColumns = "p." + strings.Join(Columns, ", p.") + `,
+        COALESCE(b.Brand, '') AS Brand,
+        COALESCE(c.Concern, '') AS Concern,
+        COALESCE(k.Key_Ingredients, '') AS Key_Ingredients,
+        COALESCE(s.Skin_Type, '') AS Skin_Type`
+
+const namedJoins = `
+    LEFT JOIN Brand b ON p.Brand_ID = b.Brand_ID
+    LEFT JOIN Concern c ON p.Concern_ID = c.Concern_ID
+    LEFT JOIN Key_Ingredients k ON p.Key_Ingredients_ID = k.Key_Ingredients_ID
+    LEFT JOIN Skin_Type s ON p.Skin_Type_ID = s.Skin_Type_ID`
+
+// scanNamedProduct reads one row selected with namedColumns, followed by any
+// extra columns into extra.
+func scanNamedProduct(row interface{ Scan(...interface{}) error }, extra ...interface{}) (Product, error) {
+	var product Product
+	dest := append([]interface{}{&product.ProductID, &product.ProductName, &product.AllIngredients, &product.ProductURL, &product.ConcernID,
+		&product.SkinTypeID, &product.BrandID, &product.ProductTypeID, &product.KeyIngredientsID, &product.ImageURL,
+		&product.Brand, &product.Concern, &product.KeyIngredients, &product.SkinType}, extra...)
+	err := row.Scan(dest...)
+	return product, err
+}
+
+// query runs one of the selection queries, which select namedColumns
+// followed by the concern and skin type matched and whether that was through
+// a wildcard.
+func (s *SQLStore) query(query string, args ...interface{}) ([]Product, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var products []Product
+	for rows.Next() {
+		var concernID, skinTypeID int
+		var matchedViaWildcard bool
+		product, err := scanNamedProduct(rows, &concernID, &skinTypeID, &matchedViaWildcard)
+		if err != nil {
+			return nil, err
+		}
+		product.ConcernID, product.SkinTypeID, product.MatchedViaWildcard = concernID, skinTypeID, matchedViaWildcard
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// Free the connection for loadLinks; SQLite has only the one
+	rows.Close()
+	return products, s.loadLinks(pointers(products))
+}
+
+// MemoryStore keeps products in process memory and resolves the joined
+// names through the other entity stores. It is safe for concurrent use.
+type MemoryStore struct {
+	mu             sync.RWMutex
+	rows           map[int]Product
+	ingredients    map[int][]Ingredient
+	lastID         int
+	brands         brand.Store
+	concerns       concern.Store
+	skinTypes      skin_type.Store
+	keyIngredients key_ingredients.Store
+	attributes     ingredient_attribute.Store
+	normalizer     *ingredient_synonym.Normalizer
+}
+
+func NewMemoryStore(brands brand.Store, concerns concern.Store, skinTypes skin_type.Store, keyIngredients key_ingredients.Store,
+	attributes ingredient_attribute.Store, normalizer *ingredient_synonym.Normalizer) *MemoryStore {
+	return &MemoryStore{
+		rows:           make(map[int]Product),
+		ingredients:    make(map[int][]Ingredient),
+		brands:         brands,
+		concerns:       concerns,
+		skinTypes:      skinTypes,
+		keyIngredients: keyIngredients,
+		attributes:     attributes,
+		normalizer:     normalizer,
+	}
+}
+
+func (s *MemoryStore) List() ([]Product, error) {
+	index, err := s.attributeIndex()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	products := s.sorted(index, func(Product) bool { return true })
+	s.mu.RUnlock()
+	for i, product := range products {
+		if products[i], _, err = s.named(product); err != nil {
+			return nil, err
+		}
+	}
+	return products, nil
+}
+
+func (s *MemoryStore) Get(id int) (Product, error) {
+	index, err := s.attributeIndex()
+	if err != nil {
+		return Product{}, err
+	}
+	s.mu.RLock()
+	product, ok := s.rows[id]
+	if !ok {
+		s.mu.RUnlock()
+		return Product{}, sql.ErrNoRows
+	}
+	product = copyLinks(product)
+	product.Badges = s.badges(id, index)
+	s.mu.RUnlock()
+	product, _, err = s.named(product)
+	return product, err
+}
+
+func (s *MemoryStore) Create(product Product) (Product, error) {
+	ingredients, err := parseIngredients(product.AllIngredients, s.normalizer)
+	if err != nil {
+		return Product{}, err
+	}
+	index, err := s.attributeIndex()
+	if err != nil {
+		return Product{}, err
+	}
+	s.mu.Lock()
+	s.lastID++
+	product.ProductID = s.lastID
+	s.rows[product.ProductID] = copyLinks(product)
+	s.ingredients[product.ProductID] = ingredients
+	product.Badges = s.badges(product.ProductID, index)
+	s.mu.Unlock()
+	product, _, err = s.named(product)
+	return product, err
+}
+
+func (s *MemoryStore) Update(product Product) (Product, error) {
+	ingredients, err := parseIngredients(product.AllIngredients, s.normalizer)
+	if err != nil {
+		return Product{}, err
+	}
+	index, err := s.attributeIndex()
+	if err != nil {
+		return Product{}, err
+	}
+	s.mu.Lock()
+	if _, ok := s.rows[product.ProductID]; !ok {
+		s.mu.Unlock()
+		return Product{}, sql.ErrNoRows
+	}
+	s.rows[product.ProductID] = copyLinks(product)
+	s.ingredients[product.ProductID] = ingredients
+	product.Badges = s.badges(product.ProductID, index)
+	s.mu.Unlock()
+	product, _, err = s.named(product)
+	return product, err
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	delete(s.ingredients, id)
+	return nil
+}
+
+func (s *MemoryStore) Ingredients(productID int) ([]Ingredient, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.rows[productID]; !ok {
+		return nil, sql.ErrNoRows
+	}
+	return s.ingredients[productID], nil
+}
+
+func (s *MemoryStore) IngredientLists() (map[int][]Ingredient, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	lists := make(map[int][]Ingredient, len(s.ingredients))
+	for id, ingredients := range s.ingredients {
+		lists[id] = ingredients
+	}
+	return lists, nil
+}
+
+func (s *MemoryStore) SyncIngredients() error {
+	s.mu.RLock()
+	products := s.sorted(nil, func(Product) bool { return true })
+	s.mu.RUnlock()
+
+	parsed := make(map[int][]Ingredient, len(products))
+	for _, product := range products {
+		ingredients, err := parseIngredients(product.AllIngredients, s.normalizer)
+		if err != nil {
+			return err
+		}
+		parsed[product.ProductID] = ingredients
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, ingredients := range parsed {
+		// Skip products deleted while parsing
+		if _, ok := s.rows[id]; ok {
+			s.ingredients[id] = ingredients
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Select(concernID, skinTypeID int, options SelectOptions) ([]Product, error) {
+	return s.selectLinked(concernID, skinTypeID, func(Product) bool { return true }, options)
+}
+
+func (s *MemoryStore) SelectByType(concernID, skinTypeID, productTypeID int, options SelectOptions) ([]Product, error) {
+	return s.selectLinked(concernID, skinTypeID, func(p Product) bool { return p.ProductTypeID == productTypeID }, options)
+}
+
+// selectLinked mirrors the SQL selection queries. Like their INNER JOINs,
+// products pointing at a missing row are dropped.
+func (s *MemoryStore) selectLinked(concernID, skinTypeID int, keep func(Product) bool, options SelectOptions) ([]Product, error) {
+	index, err := s.attributeIndex()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	candidates := s.sorted(index, func(p Product) bool {
+		return keep(p) && options.Ingredients.Matches(s.ingredients[p.ProductID], p.Badges)
+	})
+	s.mu.RUnlock()
+
+	concernWildcard := func(id int) (bool, error) { c, err := s.concerns.Get(id); return c.Wildcard, err }
+	skinTypeWildcard := func(id int) (bool, error) { st, err := s.skinTypes.Get(id); return st.Wildcard, err }
+	var selected []Product
+	for _, product := range candidates {
+		concern, err := linkedVia(product.ConcernIDs, concernID, options.Wildcards, concernWildcard)
+		if err != nil {
+			return nil, err
+		}
+		skinType, err := linkedVia(product.SkinTypeIDs, skinTypeID, options.Wildcards, skinTypeWildcard)
+		if err != nil {
+			return nil, err
+		}
+		if concern == 0 || skinType == 0 {
+			continue
+		}
+		product.ConcernID, product.SkinTypeID = concern, skinType
+		product.MatchedViaWildcard = concern != concernID || skinType != skinTypeID
+		named, ok, err := s.named(product)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			selected = append(selected, named)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		a, b := selected[i], selected[j]
+		if a.ProductTypeID != b.ProductTypeID {
+			return a.ProductTypeID < b.ProductTypeID
+		}
+		return !a.MatchedViaWildcard && b.MatchedViaWildcard
+	})
+	return selected, nil
+}
+
+// linkedVia returns wanted if it is one of ids, or else, when wildcards are
+// allowed, the lowest of ids that is a wildcard. It returns 0 if neither is
+// found.
+func linkedVia(ids []int, wanted int, wildcards bool, isWildcard func(id int) (bool, error)) (int, error) {
+	if contains(ids, wanted) {
+		return wanted, nil
+	}
+	if !wildcards {
+		return 0, nil
+	}
+	sorted := append([]int{}, ids...)
+	sort.Ints(sorted)
+	for _, id := range sorted {
+		wildcard, err := isWildcard(id)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if wildcard {
+			return id, nil
+		}
+	}
+	return 0, nil
+}
+
+func (s *MemoryStore) Page(query PageQuery) ([]Product, int, error) {
+	index, err := s.attributeIndex()
+	if err != nil {
+		return nil, 0, err
+	}
+	s.mu.RLock()
+	all := s.sorted(index, func(p Product) bool {
+		return query.Filter.Matches(p) && query.Filter.Ingredients.Matches(s.ingredients[p.ProductID], p.Badges)
+	})
+	s.mu.RUnlock()
+
+	// Names are needed to sort by brand, so every product is named first
+	for i, product := range all {
+		named, _, err := s.named(product)
+		if err != nil {
+			return nil, 0, err
+		}
+		all[i] = named
+	}
+	key := func(p Product) string {
+		switch query.Sort {
+		case "name":
+			return strings.ToLower(p.ProductName)
+		case "brand":
+			return strings.ToLower(p.Brand)
+		}
+		return ""
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		a, b := all[i], all[j]
+		switch {
+		case query.Sort == "type" && a.ProductTypeID != b.ProductTypeID:
+			return (a.ProductTypeID < b.ProductTypeID) != query.Descending
+		case query.Sort == "name" || query.Sort == "brand":
+			if key(a) != key(b) {
+				return (key(a) < key(b)) != query.Descending
+			}
+		case query.Sort == "" && a.ProductID != b.ProductID:
+			return (a.ProductID < b.ProductID) != query.Descending
+		}
+		return a.ProductID < b.ProductID
+	})
+
+	total := len(all)
+	if query.Offset >= total {
+		return nil, total, nil
+	}
+	end := query.Offset + query.Limit
+	if query.Limit == 0 || end > total {
+		end = total
+	}
+	return all[query.Offset:end], total, nil
+}
+
+func (s *MemoryStore) Search(query SearchQuery) ([]Match, int, error) {
+	index, err := s.attributeIndex()
+	if err != nil {
+		return nil, 0, err
+	}
+	s.mu.RLock()
+	all := s.sorted(index, func(Product) bool { return true })
+	s.mu.RUnlock()
+
+	var matches []Match
+	for _, product := range all {
+		named, _, err := s.named(product)
+		if err != nil {
+			return nil, 0, err
+		}
+		keyScore, err := s.keyIngredientScore(named, query.Terms)
+		if err != nil {
+			return nil, 0, err
+		}
+		score := nameWeight*termScore(named.ProductName, query.Terms) +
+			brandWeight*termScore(named.Brand, query.Terms) +
+			keyIngredientWeight*keyScore +
+			ingredientsWeight*termScore(named.AllIngredients, query.Terms)
+		if score > 0 {
+			matches = append(matches, Match{Product: named, Score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+
+	total := len(matches)
+	if query.Offset >= total {
+		return nil, total, nil
+	}
+	end := query.Offset + query.Limit
+	if end > total {
+		end = total
+	}
+	return matches[query.Offset:end], total, nil
+}
+
+// keyIngredientScore scores the names of every key ingredient product is
+// linked to against terms, as the SQL store's search index holds them all.
+func (s *MemoryStore) keyIngredientScore(product Product, terms []string) (float64, error) {
+	var score float64
+	for _, id := range product.KeyIngredientIDs {
+		k, err := s.keyIngredients.Get(id)
+		if err == sql.ErrNoRows {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		score += termScore(k.KeyIngredient, terms)
+	}
+	return score, nil
+}
+
+// sorted returns copies of the products accepted by keep, ordered by product
+// type and then ID, with their badges derived from index before keep sees
+// them. A nil index leaves the badges out. Callers must hold s.mu.
+func (s *MemoryStore) sorted(index map[string]ingredient_attribute.IngredientAttribute, keep func(Product) bool) []Product {
+	var products []Product
+	for _, product := range s.rows {
+		product = copyLinks(product)
+		if index != nil {
+			product.Badges = s.badges(product.ProductID, index)
+		}
+		if keep(product) {
+			products = append(products, product)
+		}
+	}
+	sort.Slice(products, func(i, j int) bool {
+		if products[i].ProductTypeID != products[j].ProductTypeID {
+			return products[i].ProductTypeID < products[j].ProductTypeID
+		}
+		return products[i].ProductID < products[j].ProductID
+	})
+	return products
+}
+
+// named fills in the brand, concern, key ingredient and skin type names of
+// product. ok is false if any of the rows it points at is missing, in which
+// case that name is left empty.
+func (s *MemoryStore) named(product Product) (Product, bool, error) {
+	ok := true
+	b, err := s.brands.Get(product.BrandID)
+	if err == sql.ErrNoRows {
+		ok = false
+	} else if err != nil {
+		return Product{}, false, err
+	}
+	c, err := s.concerns.Get(product.ConcernID)
+	if err == sql.ErrNoRows {
+		ok = false
+	} else if err != nil {
+		return Product{}, false, err
+	}
+	k, err := s.keyIngredients.Get(product.KeyIngredientsID)
+	if err == sql.ErrNoRows {
+		ok = false
+	} else if err != nil {
+		return Product{}, false, err
+	}
+	st, err := s.skinTypes.Get(product.SkinTypeID)
+	if err == sql.ErrNoRows {
+		ok = false
+	} else if err != nil {
+		return Product{}, false, err
+	}
+	product.Brand = b.Brand
+	product.Concern = c.Concern
+	product.KeyIngredients = k.KeyIngredient
+	product.SkinType = st.SkinType
+	return product, ok, nil
+}
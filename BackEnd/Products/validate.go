@@ -0,0 +1,101 @@
+package products
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Brand"
+	"BackEnd/Concern"
+	"BackEnd/Key_Ingredients"
+	"BackEnd/Product_Type"
+	"BackEnd/Skin_Type"
+	"BackEnd/Validation"
+	"fmt"
+)
+
+// Widths of the Products columns
+const (
+	maxNameLength        = 200
+	maxIngredientsLength = 10000
+	maxURLLength         = 500
+)
+
+// References holds the stores for every table a product points at, so that
+// validation can reject dangling IDs before they reach the database.
+type References struct {
+	Concerns       concern.Store
+	SkinTypes      skin_type.Store
+	Brands         brand.Store
+	ProductTypes   product_type.Store
+	KeyIngredients key_ingredients.Store
+}
+
+// Validate checks a product before it is written and returns every problem
+// found, or nil. The error is non-nil only if a reference lookup failed. New
+// rows are numbered by the database, so the product_id is only checked by
+// ValidateReplacement.
+func Validate(product Product, refs References) ([]api_error.FieldError, error) {
+	var v validation.Validator
+	v.Required("product_name", product.ProductName)
+	v.MaxLength("product_name", product.ProductName, maxNameLength)
+	v.Required("all_ingredients", product.AllIngredients)
+	v.MaxLength("all_ingredients", product.AllIngredients, maxIngredientsLength)
+	v.URL("product_url", product.ProductURL)
+	v.MaxLength("product_url", product.ProductURL, maxURLLength)
+	v.URL("image_url", product.ImageURL)
+	v.MaxLength("image_url", product.ImageURL, maxURLLength)
+
+	references := []struct {
+		field string
+		id    int
+		get   func(id int) error
+	}{
+		{"concern_id", product.ConcernID, func(id int) error { _, err := refs.Concerns.Get(id); return err }},
+		{"skin_type_id", product.SkinTypeID, func(id int) error { _, err := refs.SkinTypes.Get(id); return err }},
+		{"brand_id", product.BrandID, func(id int) error { _, err := refs.Brands.Get(id); return err }},
+		{"product_type_id", product.ProductTypeID, func(id int) error { _, err := refs.ProductTypes.Get(id); return err }},
+		{"key_ingredients_id", product.KeyIngredientsID, func(id int) error { _, err := refs.KeyIngredients.Get(id); return err }},
+	}
+	for _, ref := range references {
+		if err := v.Reference(ref.field, ref.id, ref.get); err != nil {
+			return nil, err
+		}
+	}
+
+	// Every linked ID must exist too; the primary is checked above
+	lists := []struct {
+		field   string
+		ids     []int
+		primary int
+		get     func(id int) error
+	}{
+		{"concern_ids", product.ConcernIDs, product.ConcernID, references[0].get},
+		{"skin_type_ids", product.SkinTypeIDs, product.SkinTypeID, references[1].get},
+		{"key_ingredients_ids", product.KeyIngredientIDs, product.KeyIngredientsID, references[4].get},
+	}
+	for _, list := range lists {
+		if len(list.ids) > maxLinks {
+			v.Add(list.field, api_error.Invalid, fmt.Sprintf("%s may list at most %d IDs", list.field, maxLinks))
+			continue
+		}
+		for _, id := range list.ids {
+			if id == list.primary {
+				continue
+			}
+			if err := v.Reference(list.field, id, list.get); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return v.Errors(), nil
+}
+
+// ValidateReplacement checks a product sent to replace a stored row, which
+// must also name the row's product_id.
+func ValidateReplacement(product Product, refs References) ([]api_error.FieldError, error) {
+	var v validation.Validator
+	v.Positive("product_id", product.ProductID)
+	fields, err := Validate(product, refs)
+	if err != nil {
+		return nil, err
+	}
+	return append(v.Errors(), fields...), nil
+}
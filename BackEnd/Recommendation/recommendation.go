@@ -0,0 +1,306 @@
+package recommendation
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Condition_Concern"
+	"BackEnd/Ingredient_Attribute"
+	"BackEnd/Product_Type"
+	"BackEnd/Products"
+	"BackEnd/Request"
+	"BackEnd/Skin_Type"
+	"BackEnd/Validation"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Most conditions one request may list
+const maxConditions = 20
+
+// Width of the Label column the labels are looked up in
+const maxLabelLength = 100
+
+// A product found only through a wildcard concern or skin type counts for
+// this share of the condition's weight.
+const wildcardWeight = 0.5
+
+// Sources holds the stores recommendations are drawn from.
+type Sources struct {
+	Products     products.Store
+	Conditions   condition_concern.Store
+	SkinTypes    skin_type.Store
+	ProductTypes product_type.Store
+}
+
+// Condition is one condition detected in the user's photo. Confidence is the
+// model's confidence between 0 and 1, and Boxes the number of areas it was
+// found in; either may be left out.
+type Condition struct {
+	Label      string   `json:"label"`
+	Confidence *float64 `json:"confidence"`
+	Boxes      *int     `json:"boxes"`
+}
+
+// Request is the body of POST /recommendations. ProductTypeID narrows the
+// products to one type, Badges to those that earned every badge named, and
+// Limit caps how many are returned, which is all of them when left out; all
+// are optional. Wildcard concerns and
+// skin types match unless Wildcards is false.
+type Request struct {
+	Conditions    []Condition `json:"conditions"`
+	SkinTypeID    int         `json:"skin_type_id"`
+	ProductTypeID int         `json:"product_type_id"`
+	Badges        []string    `json:"badges"`
+	Wildcards     *bool       `json:"wildcards"`
+	Limit         int         `json:"limit"`
+}
+
+// Recommendation is a product recommended for one or more of the conditions,
+// with the labels it was found for and its score. The product is named after
+// the concern that contributed most to the score.
+type Recommendation struct {
+	products.Product
+	Score      float64  `json:"score"`
+	Conditions []string `json:"conditions"`
+}
+
+// Meta describes the recommendations returned: Total counts every product
+// recommended, of which at most Limit were returned when a limit was given.
+type Meta struct {
+	Total int `json:"total"`
+	Limit int `json:"limit,omitempty"`
+}
+
+// Recommend products for the conditions detected in a photo. Labels are
+// mapped to concerns through the condition table; labels it does not know are
+// listed in unmatched_labels rather than failing the request. Each product
+// appears once, best first: its score adds up the weight of every condition
+// it was found for, where a condition weighs its confidence, raised by the
+// number of boxes, and a wildcard match counts for less than a direct one.
+func PostRecommendations(c *gin.Context, sources Sources) {
+	var body Request
+	if !request.HasJSONBody(c) {
+		api_error.Respond(c, api_error.BadRequest("Request body must be JSON"))
+		return
+	}
+	if err := request.BindJSON(c, &body); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before selecting
+	fields, err := validate(body, sources)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+
+	// Weigh each concern by the conditions mapped to it
+	mappings, err := sources.Conditions.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	weights := make(map[int]float64)
+	labels := make(map[int][]string)
+	var concernIDs []int
+	unmatched := []string{}
+	for _, condition := range body.Conditions {
+		mapping, ok := condition_concern.Lookup(mappings, condition.Label)
+		if !ok {
+			if !containsLabel(unmatched, condition.Label) {
+				unmatched = append(unmatched, strings.TrimSpace(condition.Label))
+			}
+			continue
+		}
+		if _, seen := weights[mapping.ConcernID]; !seen {
+			concernIDs = append(concernIDs, mapping.ConcernID)
+		}
+		weights[mapping.ConcernID] += weight(condition)
+		// Report the label as the table spells it
+		if !containsLabel(labels[mapping.ConcernID], mapping.Label) {
+			labels[mapping.ConcernID] = append(labels[mapping.ConcernID], mapping.Label)
+		}
+	}
+
+	// Select the products for each concern once, merging repeats
+	options := products.SelectOptions{Wildcards: body.Wildcards == nil || *body.Wildcards}
+	for _, name := range body.Badges {
+		badge, _ := ingredient_attribute.FindBadge(name)
+		options.Ingredients.Badges = append(options.Ingredients.Badges, badge.Name)
+	}
+	type candidate struct {
+		recommendation Recommendation
+		best           float64 // the largest single contribution
+		direct         bool    // found through a direct link at least once
+	}
+	byID := make(map[int]*candidate)
+	var ranked []*candidate
+	for _, concernID := range concernIDs {
+		var selected []products.Product
+		if body.ProductTypeID > 0 {
+			selected, err = sources.Products.SelectByType(concernID, body.SkinTypeID, body.ProductTypeID, options)
+		} else {
+			selected, err = sources.Products.Select(concernID, body.SkinTypeID, options)
+		}
+		if err != nil {
+			api_error.Respond(c, err)
+			return
+		}
+		for _, product := range selected {
+			contribution := weights[concernID]
+			if product.MatchedViaWildcard {
+				contribution *= wildcardWeight
+			}
+			found, ok := byID[product.ProductID]
+			if !ok {
+				found = &candidate{recommendation: Recommendation{Conditions: []string{}}}
+				byID[product.ProductID] = found
+				ranked = append(ranked, found)
+			}
+			// Name the product after the concern that counts most
+			if !ok || contribution > found.best {
+				found.recommendation.Product = product
+				found.best = contribution
+			}
+			found.direct = found.direct || !product.MatchedViaWildcard
+			found.recommendation.Score += contribution
+			for _, label := range labels[concernID] {
+				if !containsLabel(found.recommendation.Conditions, label) {
+					found.recommendation.Conditions = append(found.recommendation.Conditions, label)
+				}
+			}
+		}
+	}
+
+	// Best first; ties follow the routine order of the product types, with
+	// types outside the routine last
+	steps, err := stepOrders(sources.ProductTypes)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].recommendation, ranked[j].recommendation
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if stepA, stepB := step(steps, a.ProductTypeID), step(steps, b.ProductTypeID); stepA != stepB {
+			return stepA < stepB
+		}
+		if a.ProductTypeID != b.ProductTypeID {
+			return a.ProductTypeID < b.ProductTypeID
+		}
+		return a.ProductID < b.ProductID
+	})
+	meta := Meta{Total: len(ranked), Limit: body.Limit}
+	if body.Limit > 0 && len(ranked) > body.Limit {
+		ranked = ranked[:body.Limit]
+	}
+	data := make([]Recommendation, len(ranked))
+	for i, found := range ranked {
+		data[i] = found.recommendation
+		data[i].MatchedViaWildcard = !found.direct
+		data[i].Score = math.Round(data[i].Score*1000) / 1000
+	}
+	c.JSON(http.StatusOK, gin.H{"data": data, "meta": meta, "unmatched_labels": unmatched})
+}
+
+// stepOrders maps the ID of each product type in the routine to its step.
+func stepOrders(store product_type.Store) (map[int]int, error) {
+	productTypes, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	steps := make(map[int]int, len(productTypes))
+	for _, productType := range productTypes {
+		if productType.StepOrder != nil {
+			steps[productType.ProductTypeID] = *productType.StepOrder
+		}
+	}
+	return steps, nil
+}
+
+// step is the step of a product type in steps, or math.MaxInt for a type
+// outside the routine.
+func step(steps map[int]int, productTypeID int) int {
+	if order, ok := steps[productTypeID]; ok {
+		return order
+	}
+	return math.MaxInt
+}
+
+// weight is how much a condition counts towards the products found for it:
+// its confidence, or 1 if none was given, raised logarithmically by the
+// number of boxes so that widespread conditions rank higher without
+// drowning out the rest.
+func weight(condition Condition) float64 {
+	w := 1.0
+	if condition.Confidence != nil {
+		w = *condition.Confidence
+	}
+	if condition.Boxes != nil {
+		w *= 1 + math.Log1p(float64(*condition.Boxes))
+	}
+	return w
+}
+
+// validate checks a request and returns every problem found, or nil. The
+// error is non-nil only if a reference lookup failed.
+func validate(body Request, sources Sources) ([]api_error.FieldError, error) {
+	var v validation.Validator
+	if len(body.Conditions) == 0 {
+		v.Add("conditions", api_error.Required, "conditions is required")
+	}
+	if len(body.Conditions) > maxConditions {
+		v.Add("conditions", api_error.Invalid, fmt.Sprintf("conditions may list at most %d conditions", maxConditions))
+	}
+	for i, condition := range body.Conditions {
+		field := fmt.Sprintf("conditions[%d]", i)
+		v.Required(field+".label", condition.Label)
+		v.MaxLength(field+".label", condition.Label, maxLabelLength)
+		if condition.Confidence != nil && (*condition.Confidence < 0 || *condition.Confidence > 1) {
+			v.Add(field+".confidence", api_error.Invalid, field+".confidence must be between 0 and 1")
+		}
+		if condition.Boxes != nil && *condition.Boxes < 0 {
+			v.Add(field+".boxes", api_error.Invalid, field+".boxes must not be negative")
+		}
+	}
+	err := v.Reference("skin_type_id", body.SkinTypeID, func(id int) error { _, err := sources.SkinTypes.Get(id); return err })
+	if err != nil {
+		return nil, err
+	}
+	if body.ProductTypeID != 0 {
+		err := v.Reference("product_type_id", body.ProductTypeID, func(id int) error { _, err := sources.ProductTypes.Get(id); return err })
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, name := range body.Badges {
+		if _, ok := ingredient_attribute.FindBadge(name); !ok {
+			v.Add(fmt.Sprintf("badges[%d]", i), api_error.Invalid,
+				"badges must each be one of "+strings.Join(ingredient_attribute.BadgeNames(), ", "))
+		}
+	}
+	if body.Limit < 0 || body.Limit > request.MaxLimit {
+		v.Add("limit", api_error.Invalid, fmt.Sprintf("limit must be between 1 and %d", request.MaxLimit))
+	}
+	return v.Errors(), nil
+}
+
+// containsLabel reports whether labels holds label, ignoring case and
+// surrounding space.
+func containsLabel(labels []string, label string) bool {
+	for _, candidate := range labels {
+		if strings.EqualFold(candidate, strings.TrimSpace(label)) {
+			return true
+		}
+	}
+	return false
+}
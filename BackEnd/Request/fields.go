@@ -0,0 +1,86 @@
+package request
+
+import (
+	"BackEnd/Api_Error"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"reflect"
+	"strings"
+)
+
+// Fields reads the comma-separated fields query parameter, which limits each
+// item of a response to the named JSON fields of entity. It returns nil when
+// the parameter is absent, meaning every field is sent.
+func Fields(c *gin.Context, entity interface{}) ([]string, error) {
+	raw, ok := c.GetQuery("fields")
+	if !ok {
+		return nil, nil
+	}
+	known := jsonFields(reflect.TypeOf(entity))
+	var fields []string
+	var problems []api_error.FieldError
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !known[field] {
+			problems = append(problems, api_error.FieldError{
+				Field:   "fields",
+				Code:    api_error.Invalid,
+				Message: "Unknown field " + field,
+			})
+			continue
+		}
+		fields = append(fields, field)
+	}
+	if problems != nil {
+		return nil, api_error.BadRequest("Invalid query parameters", problems...)
+	}
+	if fields == nil {
+		return nil, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+			Field:   "fields",
+			Code:    api_error.Required,
+			Message: "fields must name at least one field",
+		})
+	}
+	return fields, nil
+}
+
+// SelectFields returns items, which must be a slice, with each element cut
+// down to fields. A nil fields leaves the items untouched.
+func SelectFields(items interface{}, fields []string) (interface{}, error) {
+	if fields == nil {
+		return items, nil
+	}
+	list := reflect.ValueOf(items)
+	selected := make([]map[string]json.RawMessage, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		encoded, err := json.Marshal(list.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(encoded, &all); err != nil {
+			return nil, err
+		}
+		item := make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			item[field] = all[field]
+		}
+		selected = append(selected, item)
+	}
+	return selected, nil
+}
+
+// jsonFields lists the JSON names of a struct's fields.
+func jsonFields(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
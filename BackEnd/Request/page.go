@@ -0,0 +1,101 @@
+package request
+
+import (
+	"BackEnd/Api_Error"
+	"encoding/base64"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"strconv"
+)
+
+// Page sizes for listing endpoints
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// Page is the slice of a listing selected by the limit and cursor query
+// parameters.
+type Page struct {
+	Offset int
+	Limit  int
+}
+
+// PageMeta describes a page to the client. NextCursor is empty on the last
+// page.
+type PageMeta struct {
+	Limit      int    `json:"limit"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// PageParams reads limit and cursor. The cursor is opaque to clients: it is
+// the next_cursor of the previous page, and is left out for the first.
+func PageParams(c *gin.Context) (Page, error) {
+	page := Page{Limit: DefaultLimit}
+	var fields []api_error.FieldError
+	if raw, ok := c.GetQuery("limit"); ok {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			fields = append(fields, api_error.FieldError{
+				Field:   "limit",
+				Code:    api_error.Invalid,
+				Message: fmt.Sprintf("limit must be between 1 and %d", MaxLimit),
+			})
+		}
+		page.Limit = limit
+	}
+	if raw, ok := c.GetQuery("cursor"); ok {
+		offset, err := decodeCursor(raw)
+		if err != nil {
+			fields = append(fields, api_error.FieldError{
+				Field:   "cursor",
+				Code:    api_error.Invalid,
+				Message: "cursor must be a next_cursor returned by this endpoint",
+			})
+		}
+		page.Offset = offset
+	}
+	if fields != nil {
+		return Page{}, api_error.BadRequest("Invalid query parameters", fields...)
+	}
+	return page, nil
+}
+
+// Paged reports whether the request asked for a page at all, with either a
+// limit or a cursor. Listings that predate paging answer other requests with
+// every row, as they always have.
+func Paged(c *gin.Context) bool {
+	_, limit := c.GetQuery("limit")
+	_, cursor := c.GetQuery("cursor")
+	return limit || cursor
+}
+
+// Meta describes the page to the client, given the number of rows in the
+// whole listing.
+func (p Page) Meta(total int) PageMeta {
+	meta := PageMeta{Limit: p.Limit, Total: total}
+	if next := p.Offset + p.Limit; next < total {
+		meta.NextCursor = encodeCursor(next)
+	}
+	return meta
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil {
+		return 0, err
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative offset %d", offset)
+	}
+	return offset, nil
+}
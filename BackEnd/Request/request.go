@@ -0,0 +1,141 @@
+package request
+
+import (
+	"BackEnd/Api_Error"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// HasJSONBody reports whether the client sent a JSON request body. Create and
+// update endpoints bind the body to the entity struct when it is present.
+func HasJSONBody(c *gin.Context) bool {
+	return c.ContentType() == gin.MIMEJSON
+}
+
+// BindJSON decodes the JSON request body into obj. A value of the wrong type
+// is reported against its field; any other decoding failure as a whole.
+func BindJSON(c *gin.Context, obj interface{}) error {
+	err := c.ShouldBindJSON(obj)
+	if err == nil {
+		return nil
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return api_error.BadRequest("Invalid request body", api_error.FieldError{
+			Field:   typeErr.Field,
+			Code:    api_error.Invalid,
+			Message: fmt.Sprintf("%s must be of type %s", typeErr.Field, typeErr.Type),
+		})
+	}
+	return api_error.BadRequest("Invalid request body: " + err.Error())
+}
+
+// BindPatch decodes a partial update from the JSON request body over obj,
+// which should already hold the stored row, so fields the client leaves out
+// keep their current values. PATCH has no query parameter fallback.
+func BindPatch(c *gin.Context, obj interface{}) error {
+	if !HasJSONBody(c) {
+		return api_error.BadRequest("PATCH requires a JSON body")
+	}
+	return BindJSON(c, obj)
+}
+
+// SameID rejects a body that tries to change the ID named in the path.
+func SameID(field string, sent, id int) error {
+	if sent == id {
+		return nil
+	}
+	return api_error.BadRequest("Invalid request body", api_error.FieldError{
+		Field:   field,
+		Code:    api_error.Invalid,
+		Message: fmt.Sprintf("%s cannot be changed from %d", field, id),
+	})
+}
+
+// DeprecatedQuery marks a response as having been built from query parameters
+// instead of a JSON body, so clients can find and migrate remaining callers.
+func DeprecatedQuery(c *gin.Context) {
+	c.Header("Deprecation", "true")
+	c.Header("Warning", `299 - "Query parameters are deprecated on this endpoint; send a JSON body"`)
+	log.Printf("Deprecated query parameters used for %s %s", c.Request.Method, c.Request.URL.Path)
+}
+
+// IntParam names an integer query parameter and where to store it. An
+// Optional parameter may be left out, leaving Dest unchanged.
+type IntParam struct {
+	Name     string
+	Dest     *int
+	Optional bool
+}
+
+// QueryInts parses integer query parameters, reporting every one that is
+// missing or not a number.
+func QueryInts(c *gin.Context, params ...IntParam) error {
+	var fields []api_error.FieldError
+	for _, param := range params {
+		raw, ok := c.GetQuery(param.Name)
+		if !ok && param.Optional {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			fields = append(fields, api_error.FieldError{
+				Field:   param.Name,
+				Code:    api_error.Invalid,
+				Message: "Invalid " + param.Name,
+			})
+			continue
+		}
+		*param.Dest = value
+	}
+	if fields != nil {
+		return api_error.BadRequest("Invalid query parameters", fields...)
+	}
+	return nil
+}
+
+// IntListParam names a multi-valued integer query parameter and where to
+// store its values. Values may be repeated (a=1&a=2), comma-separated (a=1,2)
+// or both.
+type IntListParam struct {
+	Name string
+	Dest *[]int
+}
+
+// QueryIntLists parses multi-valued integer query parameters, reporting every
+// one holding a value that is not a number. Absent parameters leave Dest nil.
+func QueryIntLists(c *gin.Context, params ...IntListParam) error {
+	var fields []api_error.FieldError
+	for _, param := range params {
+		var values []int
+		valid := true
+		for _, raw := range c.QueryArray(param.Name) {
+			for _, part := range strings.Split(raw, ",") {
+				value, err := strconv.Atoi(strings.TrimSpace(part))
+				if err != nil {
+					valid = false
+					continue
+				}
+				values = append(values, value)
+			}
+		}
+		if !valid {
+			fields = append(fields, api_error.FieldError{
+				Field:   param.Name,
+				Code:    api_error.Invalid,
+				Message: param.Name + " must be a list of integers",
+			})
+			continue
+		}
+		*param.Dest = values
+	}
+	if fields != nil {
+		return api_error.BadRequest("Invalid query parameters", fields...)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package routine
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Ingredient_Interaction"
+	"BackEnd/Products"
+	"BackEnd/Request"
+	"BackEnd/Validation"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Most products one check may list
+const maxCheckedProducts = 20
+
+// Interaction is an interaction rule that applies between two products of a
+// routine. Ingredients holds the ingredient of each product it applies to, in
+// the same order as ProductIDs.
+type Interaction struct {
+	ProductIDs    [2]int    `json:"product_ids"`
+	Ingredients   [2]string `json:"ingredients"`
+	Severity      string    `json:"severity"`
+	Reason        string    `json:"reason"`
+	InteractionID int       `json:"interaction_id"`
+}
+
+// CheckRequest is the body of POST /routines/check.
+type CheckRequest struct {
+	ProductIDs []int `json:"product_ids"`
+}
+
+// Check the products of a routine against the interaction rules, returning
+// every pair of products a rule applies to, most serious first
+func CheckRoutine(c *gin.Context, sources Sources) {
+	var body CheckRequest
+	if !request.HasJSONBody(c) {
+		api_error.Respond(c, api_error.BadRequest("Request body must be JSON"))
+		return
+	}
+	if err := request.BindJSON(c, &body); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Every product must exist
+	var v validation.Validator
+	if len(body.ProductIDs) == 0 {
+		v.Add("product_ids", api_error.Required, "product_ids is required")
+	}
+	if len(body.ProductIDs) > maxCheckedProducts {
+		v.Add("product_ids", api_error.Invalid, fmt.Sprintf("product_ids may list at most %d products", maxCheckedProducts))
+	}
+	var routine []products.Product
+	seen := make(map[int]bool)
+	for _, id := range body.ProductIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		var product products.Product
+		err := v.Reference("product_ids", id, func(id int) error {
+			var err error
+			product, err = sources.Products.Get(id)
+			return err
+		})
+		if err != nil {
+			api_error.Respond(c, err)
+			return
+		}
+		// An unknown product is left zero and reported below
+		if product.ProductID != 0 {
+			routine = append(routine, product)
+		}
+	}
+	if fields := v.Errors(); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+
+	checker, err := newChecker(sources)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	interactions, err := checker.within(routine)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": interactions})
+}
+
+// checker applies the interaction rules to products. It reads each product's
+// ingredients once.
+type checker struct {
+	sources  Sources
+	rules    []ingredient_interaction.IngredientInteraction
+	keyNames map[int]string
+	// ingredients maps each product ID to the folded names of its key and
+	// parsed ingredients, and those to the names as shown
+	ingredients map[int]map[string]string
+}
+
+func newChecker(sources Sources) (*checker, error) {
+	rules, err := sources.Interactions.List()
+	if err != nil {
+		return nil, err
+	}
+	keyIngredients, err := sources.KeyIngredients.List()
+	if err != nil {
+		return nil, err
+	}
+	k := &checker{sources: sources, keyNames: make(map[int]string), ingredients: make(map[int]map[string]string)}
+	for _, ingredient := range keyIngredients {
+		k.keyNames[ingredient.KeyIngredientsID] = ingredient.KeyIngredient
+	}
+	// Rule names are matched the way product ingredients are named
+	for _, rule := range rules {
+		if rule.IngredientA, err = sources.Normalizer.Canonical(rule.IngredientA); err != nil {
+			return nil, err
+		}
+		if rule.IngredientB, err = sources.Normalizer.Canonical(rule.IngredientB); err != nil {
+			return nil, err
+		}
+		k.rules = append(k.rules, rule)
+	}
+	return k, nil
+}
+
+// within returns the interactions between every pair of products, most
+// serious first.
+func (k *checker) within(routine []products.Product) ([]Interaction, error) {
+	interactions := []Interaction{}
+	for i := range routine {
+		for j := i + 1; j < len(routine); j++ {
+			between, err := k.between(routine[i], routine[j])
+			if err != nil {
+				return nil, err
+			}
+			interactions = append(interactions, between...)
+		}
+	}
+	sort.SliceStable(interactions, func(i, j int) bool {
+		return ingredient_interaction.Rank(interactions[i].Severity) < ingredient_interaction.Rank(interactions[j].Severity)
+	})
+	return interactions, nil
+}
+
+// between returns the interactions between two products.
+func (k *checker) between(a, b products.Product) ([]Interaction, error) {
+	inA, err := k.ingredientsOf(a)
+	if err != nil {
+		return nil, err
+	}
+	inB, err := k.ingredientsOf(b)
+	if err != nil {
+		return nil, err
+	}
+	var interactions []Interaction
+	for _, rule := range k.rules {
+		first, second := fold(rule.IngredientA), fold(rule.IngredientB)
+		var ingredients [2]string
+		switch {
+		case inA[first] != "" && inB[second] != "":
+			ingredients = [2]string{inA[first], inB[second]}
+		case inA[second] != "" && inB[first] != "":
+			ingredients = [2]string{inA[second], inB[first]}
+		default:
+			continue
+		}
+		interactions = append(interactions, Interaction{
+			ProductIDs:    [2]int{a.ProductID, b.ProductID},
+			Ingredients:   ingredients,
+			Severity:      rule.Severity,
+			Reason:        rule.Reason,
+			InteractionID: rule.InteractionID,
+		})
+	}
+	return interactions, nil
+}
+
+// conflicts reports whether product has a conflict with any of picked.
+func (k *checker) conflicts(product products.Product, picked []products.Product) (bool, error) {
+	for _, other := range picked {
+		between, err := k.between(product, other)
+		if err != nil {
+			return false, err
+		}
+		for _, interaction := range between {
+			if interaction.Severity == ingredient_interaction.Conflict {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// ingredientsOf returns the key ingredients of product and the ingredients it
+// lists, leaving out those it only may contain.
+func (k *checker) ingredientsOf(product products.Product) (map[string]string, error) {
+	if names, ok := k.ingredients[product.ProductID]; ok {
+		return names, nil
+	}
+	names := make(map[string]string)
+	for _, id := range append([]int{product.KeyIngredientsID}, product.KeyIngredientIDs...) {
+		name, ok := k.keyNames[id]
+		if !ok {
+			continue
+		}
+		canonical, err := k.sources.Normalizer.Canonical(name)
+		if err != nil {
+			return nil, err
+		}
+		names[fold(canonical)] = canonical
+	}
+	listed, err := k.sources.Products.Ingredients(product.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ingredient := range listed {
+		if !ingredient.MayContain {
+			names[fold(ingredient.Ingredient)] = ingredient.Ingredient
+		}
+	}
+	k.ingredients[product.ProductID] = names
+	return names, nil
+}
+
+// fold is the key names are matched on: case, hyphens and spacing are
+// ignored, so the key ingredient "Benzoyl-Peroxide" matches the listed
+// "BENZOYL PEROXIDE".
+func fold(name string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(strings.ToLower(name), "-", " ")), " ")
+}
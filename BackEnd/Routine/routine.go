@@ -0,0 +1,285 @@
+package routine
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Concern"
+	"BackEnd/Ingredient_Interaction"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Key_Ingredients"
+	"BackEnd/Product_Type"
+	"BackEnd/Products"
+	"BackEnd/Request"
+	"BackEnd/Skin_Type"
+	"BackEnd/Validation"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sort"
+)
+
+// Alternates offered per step unless the alternates parameter says otherwise
+const (
+	defaultAlternates = 2
+	maxAlternates     = 5
+)
+
+// The routines built, and the TimeOfDay values that keep a step or key
+// ingredient to one of them
+const (
+	morning = "AM"
+	evening = "PM"
+)
+
+// Sources holds the stores routines are built from.
+type Sources struct {
+	Products       products.Store
+	ProductTypes   product_type.Store
+	KeyIngredients key_ingredients.Store
+	Concerns       concern.Store
+	SkinTypes      skin_type.Store
+	Interactions   ingredient_interaction.Store
+	Normalizer     *ingredient_synonym.Normalizer
+}
+
+// Step is one product type of a routine, with the product recommended for it
+// and the next best ones. Product is nil if no product suits the step.
+type Step struct {
+	Step          int                `json:"step"`
+	ProductTypeID int                `json:"product_type_id"`
+	ProductType   string             `json:"product_type"`
+	Product       *products.Product  `json:"product"`
+	Alternates    []products.Product `json:"alternates"`
+}
+
+// Routine is the morning and evening routine for one concern and skin type,
+// with the interactions between the products picked for each.
+type Routine struct {
+	AM           []Step       `json:"am"`
+	PM           []Step       `json:"pm"`
+	Interactions Interactions `json:"interactions"`
+}
+
+// Interactions lists the interactions within each routine.
+type Interactions struct {
+	AM []Interaction `json:"am"`
+	PM []Interaction `json:"pm"`
+}
+
+// Build the morning and evening routine for a concern and skin type: one
+// product per product type with a step order, applied in that order. A step
+// kept to one time of day, such as sunscreen in the morning, is left out of
+// the other routine, as are products that are linked to or list a key
+// ingredient kept to the other time, such as retinol in the evening. Products
+// are picked as the selection endpoints order them, passing over any that
+// conflict with a product picked for an earlier step, and take the same
+// ingredient filter and wildcards parameters.
+func BuildRoutine(c *gin.Context, sources Sources) {
+	var concernID, skinTypeID int
+	alternates := defaultAlternates
+	err := request.QueryInts(c,
+		request.IntParam{Name: "concern", Dest: &concernID},
+		request.IntParam{Name: "skin_type", Dest: &skinTypeID},
+		request.IntParam{Name: "alternates", Dest: &alternates, Optional: true})
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if alternates < 0 || alternates > maxAlternates {
+		api_error.Respond(c, api_error.BadRequest("Invalid query parameters", api_error.FieldError{
+			Field:   "alternates",
+			Code:    api_error.Invalid,
+			Message: fmt.Sprintf("alternates must be between 0 and %d", maxAlternates),
+		}))
+		return
+	}
+	options, err := products.ParseSelectOptions(c, sources.Normalizer)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Both IDs must exist
+	var v validation.Validator
+	if err := v.Reference("concern", concernID, func(id int) error { _, err := sources.Concerns.Get(id); return err }); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := v.Reference("skin_type", skinTypeID, func(id int) error { _, err := sources.SkinTypes.Get(id); return err }); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if fields := v.Errors(); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+
+	steps, err := routineSteps(sources.ProductTypes)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	keyIngredients, err := sources.KeyIngredients.List()
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	timeOfDay := make(map[int]string, len(keyIngredients))
+	for _, ingredient := range keyIngredients {
+		timeOfDay[ingredient.KeyIngredientsID] = ingredient.TimeOfDay
+	}
+	// Each routine excludes the ingredients kept to the other
+	amOptions, err := keptTo(options, evening, keyIngredients, sources.Normalizer)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	pmOptions, err := keptTo(options, morning, keyIngredients, sources.Normalizer)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+
+	checker, err := newChecker(sources)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+
+	routine := Routine{AM: []Step{}, PM: []Step{}}
+	var amPicked, pmPicked []products.Product
+	for _, productType := range steps {
+		if productType.TimeOfDay != evening {
+			selected, err := sources.Products.SelectByType(concernID, skinTypeID, productType.ProductTypeID, amOptions)
+			if err != nil {
+				api_error.Respond(c, err)
+				return
+			}
+			step, err := pick(len(routine.AM)+1, productType, selected, morning, timeOfDay, alternates, checker, amPicked)
+			if err != nil {
+				api_error.Respond(c, err)
+				return
+			}
+			routine.AM = append(routine.AM, step)
+			if step.Product != nil {
+				amPicked = append(amPicked, *step.Product)
+			}
+		}
+		if productType.TimeOfDay != morning {
+			selected, err := sources.Products.SelectByType(concernID, skinTypeID, productType.ProductTypeID, pmOptions)
+			if err != nil {
+				api_error.Respond(c, err)
+				return
+			}
+			step, err := pick(len(routine.PM)+1, productType, selected, evening, timeOfDay, alternates, checker, pmPicked)
+			if err != nil {
+				api_error.Respond(c, err)
+				return
+			}
+			routine.PM = append(routine.PM, step)
+			if step.Product != nil {
+				pmPicked = append(pmPicked, *step.Product)
+			}
+		}
+	}
+	// Report what is left, such as cautions and synergies
+	if routine.Interactions.AM, err = checker.within(amPicked); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if routine.Interactions.PM, err = checker.within(pmPicked); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": routine})
+}
+
+// keptTo returns options excluding products that list any of the key
+// ingredients kept to time of day other, by canonical name.
+func keptTo(options products.SelectOptions, other string, keyIngredients []key_ingredients.KeyIngredients,
+	normalizer *ingredient_synonym.Normalizer) (products.SelectOptions, error) {
+	exclude := append([]string{}, options.Ingredients.Exclude...)
+	for _, ingredient := range keyIngredients {
+		if ingredient.TimeOfDay != other {
+			continue
+		}
+		normalized, err := normalizer.Normalize(ingredient.KeyIngredient)
+		if err != nil {
+			return products.SelectOptions{}, err
+		}
+		exclude = append(exclude, normalized.Name)
+	}
+	options.Ingredients.Exclude = exclude
+	return options, nil
+}
+
+// routineSteps returns the product types with a step order, in that order.
+func routineSteps(store product_type.Store) ([]product_type.ProductType, error) {
+	productTypes, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	var steps []product_type.ProductType
+	for _, productType := range productTypes {
+		if productType.StepOrder != nil {
+			steps = append(steps, productType)
+		}
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		if *steps[i].StepOrder != *steps[j].StepOrder {
+			return *steps[i].StepOrder < *steps[j].StepOrder
+		}
+		return steps[i].ProductTypeID < steps[j].ProductTypeID
+	})
+	return steps, nil
+}
+
+// pick fills in step number n of the routine for time of day when from the
+// selected products, skipping those linked to a key ingredient kept to the
+// other time of day. Products that conflict with one already picked go last,
+// so they are only picked when nothing else suits the step.
+func pick(n int, productType product_type.ProductType, selected []products.Product, when string,
+	timeOfDay map[int]string, alternates int, checker *checker, picked []products.Product) (Step, error) {
+	step := Step{
+		Step:          n,
+		ProductTypeID: productType.ProductTypeID,
+		ProductType:   productType.ProductType,
+		Alternates:    []products.Product{},
+	}
+	var compatible, conflicting []products.Product
+	for _, product := range selected {
+		if !suits(product, when, timeOfDay) {
+			continue
+		}
+		conflicts, err := checker.conflicts(product, picked)
+		if err != nil {
+			return Step{}, err
+		}
+		if conflicts {
+			conflicting = append(conflicting, product)
+		} else {
+			compatible = append(compatible, product)
+		}
+	}
+	for _, product := range append(compatible, conflicting...) {
+		if step.Product == nil {
+			product := product
+			step.Product = &product
+			continue
+		}
+		if len(step.Alternates) == alternates {
+			break
+		}
+		step.Alternates = append(step.Alternates, product)
+	}
+	return step, nil
+}
+
+// suits reports whether none of product's key ingredients is kept to a time
+// of day other than when.
+func suits(product products.Product, when string, timeOfDay map[int]string) bool {
+	for _, id := range append([]int{product.KeyIngredientsID}, product.KeyIngredientIDs...) {
+		if kept := timeOfDay[id]; kept != "" && kept != when {
+			return false
+		}
+	}
+	return true
+}
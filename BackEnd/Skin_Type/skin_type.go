@@ -1,99 +1,181 @@
 package skin_type
 
 import (
+	"BackEnd/Api_Error"
+	"BackEnd/Request"
 	"database/sql"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
 )
 
+// SkinType is one skin type. A wildcard skin type, such as All Types,
+// applies to every skin type, so the selection endpoints can offer its
+// products for any of them.
 type SkinType struct {
 	SkinTypeID int    `json:"skin_type_id"`
 	SkinType   string `json:"skin_type"`
+	Wildcard   bool   `json:"wildcard"`
 }
 
 // Get all skin types
-func GetSkinTypes(c *gin.Context, db *sql.DB) {
-	rows, err := db.Query("SELECT Skin_Type_ID, Skin_Type FROM Skin_Type")
+func GetSkinTypes(c *gin.Context, store Store) {
+	skinTypes, err := store.List()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	defer rows.Close()
-	var skinTypes []SkinType
-	for rows.Next() {
-		var skinType SkinType
-		if err := rows.Scan(&skinType.SkinTypeID, &skinType.SkinType); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		skinTypes = append(skinTypes, skinType)
-	}
 	c.JSON(http.StatusOK, skinTypes)
 }
 
+// Get a skin type by ID
+func GetSkinType(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("skin_type_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("skin_type_id"))
+		return
+	}
+	skinType, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, skinType)
+}
+
 // Create a new skin type
-func CreateSkinType(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	skinTypeIDStr := c.Query("skin_type_id")
-	skinType := c.Query("skin_type")
-	// Convert skinTypeID to int (since it will be a string from the query)
-	skinTypeID, err := strconv.Atoi(skinTypeIDStr)
+func CreateSkinType(c *gin.Context, store Store) {
+	newSkinType, err := bindSkinType(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid skin_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the new skin type struct
-	newSkinType := SkinType{
-		SkinTypeID: skinTypeID,
-		SkinType:   skinType,
+	// Validate before writing
+	if fields := Validate(newSkinType); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
 	}
-	// Execute the SQL statement with parameters
-	result, err := db.Exec("INSERT INTO Skin_Type (Skin_Type_ID, Skin_Type) VALUES (?, ?);", newSkinType.SkinTypeID, newSkinType.SkinType)
+	// Persist the skin type
+	newSkinType, err = store.Create(newSkinType)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Retrieve and set the last inserted ID if needed
-	id, _ := result.LastInsertId()
-	newSkinType.SkinTypeID = int(id)
-	// Send the response
+	// Send the response, pointing at the new row
+	c.Header("Location", fmt.Sprintf("/skin_type/%d", newSkinType.SkinTypeID))
 	c.JSON(http.StatusCreated, newSkinType)
 }
 
 // Update a skin type
-func UpdateSkinType(c *gin.Context, db *sql.DB) {
-	// Read the query parameters
-	skinTypeIDStr := c.Query("skin_type_id")
-	skinType := c.Query("skin_type")
-	// Convert skinTypeID to an integer
-	skinTypeID, err := strconv.Atoi(skinTypeIDStr)
+func UpdateSkinType(c *gin.Context, store Store) {
+	updatedSkinType, err := bindSkinType(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid skin_type_id"})
+		api_error.Respond(c, err)
 		return
 	}
-	// Prepare the updated skin type struct
-	updatedSkinType := SkinType{
-		SkinTypeID: skinTypeID,
-		SkinType:   skinType,
+	// Validate before writing
+	if fields := ValidateReplacement(updatedSkinType); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(updatedSkinType)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(updatedSkinType.SkinTypeID))
+		return
 	}
-	// Execute the SQL statement with parameters
-	_, err = db.Exec("UPDATE Skin_Type SET Skin_Type = ? WHERE Skin_Type_ID = ?", updatedSkinType.SkinType, updatedSkinType.SkinTypeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, err)
 		return
 	}
-	// Send the response
-	c.JSON(http.StatusOK, updatedSkinType)
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
+}
+
+// Update only the supplied fields of a skin type
+func PatchSkinType(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("skin_type_id"))
+	if err != nil {
+		api_error.Respond(c, api_error.InvalidParam("skin_type_id"))
+		return
+	}
+	// Start from the stored row so omitted fields keep their values
+	patched, err := store.Get(id)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.BindPatch(c, &patched); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	if err := request.SameID("skin_type_id", patched.SkinTypeID, id); err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Validate before writing
+	if fields := Validate(patched); fields != nil {
+		api_error.Respond(c, api_error.ValidationFailed(fields))
+		return
+	}
+	// Persist the changes
+	stored, err := store.Update(patched)
+	if err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	}
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	// Send the row as stored
+	c.JSON(http.StatusOK, stored)
 }
 
 // Delete a skin type
-func DeleteSkinType(c *gin.Context, db *sql.DB) {
-	id := c.Param("skin_type_id")
-	_, err := db.Exec("DELETE FROM Skin_Type WHERE Skin_Type_ID = ?", id)
+func DeleteSkinType(c *gin.Context, store Store) {
+	id, err := strconv.Atoi(c.Param("skin_type_id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		api_error.Respond(c, api_error.InvalidParam("skin_type_id"))
+		return
+	}
+	if err := store.Delete(id); err == sql.ErrNoRows {
+		api_error.Respond(c, notFound(id))
+		return
+	} else if err != nil {
+		api_error.Respond(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Skin Type deleted"})
 }
+
+// bindSkinType reads a skin type from the JSON request body. Requests
+// without one fall back to the deprecated skin_type_id and skin_type query
+// parameters.
+func bindSkinType(c *gin.Context) (SkinType, error) {
+	var skinType SkinType
+	if request.HasJSONBody(c) {
+		err := request.BindJSON(c, &skinType)
+		return skinType, err
+	}
+	request.DeprecatedQuery(c)
+	if err := request.QueryInts(c, request.IntParam{Name: "skin_type_id", Dest: &skinType.SkinTypeID, Optional: true}); err != nil {
+		return skinType, err
+	}
+	skinType.SkinType = c.Query("skin_type")
+	return skinType, nil
+}
+
+// notFound reports an unknown Skin_Type_ID.
+func notFound(id int) error {
+	return api_error.NotFound(fmt.Sprintf("Skin type %d not found", id))
+}
@@ -0,0 +1,153 @@
+package skin_type
+
+import (
+	"BackEnd/Database"
+	"database/sql"
+	"sort"
+	"sync"
+)
+
+// Store is the persistence boundary for skin types. Lookups, updates and deletes of
+// an unknown Skin_Type_ID report sql.ErrNoRows regardless of the backing implementation.
+// Create ignores any ID it is given and returns the row with the one assigned.
+type Store interface {
+	List() ([]SkinType, error)
+	Get(id int) (SkinType, error)
+	Create(skinType SkinType) (SkinType, error)
+	Update(skinType SkinType) (SkinType, error)
+	Delete(id int) error
+}
+
+// Table and Columns name the table behind SQLStore and every column it reads
+// or writes. The server compares them with the live schema before serving.
+const Table = "Skin_Type"
+
+var Columns = []string{"Skin_Type_ID", "Skin_Type", "Is_Wildcard"}
+
+// SQLStore keeps skin types in the Skin_Type table of a MySQL or SQLite database.
+type SQLStore struct {
+	db *sql.DB
+}
+
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+func (s *SQLStore) List() ([]SkinType, error) {
+	rows, err := s.db.Query("SELECT Skin_Type_ID, Skin_Type, Is_Wildcard FROM Skin_Type")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var skinTypes []SkinType
+	for rows.Next() {
+		var skinType SkinType
+		if err := rows.Scan(&skinType.SkinTypeID, &skinType.SkinType, &skinType.Wildcard); err != nil {
+			return nil, err
+		}
+		skinTypes = append(skinTypes, skinType)
+	}
+	return skinTypes, rows.Err()
+}
+
+func (s *SQLStore) Get(id int) (SkinType, error) {
+	var skinType SkinType
+	err := s.db.QueryRow("SELECT Skin_Type_ID, Skin_Type, Is_Wildcard FROM Skin_Type WHERE Skin_Type_ID = ?", id).
+		Scan(&skinType.SkinTypeID, &skinType.SkinType, &skinType.Wildcard)
+	return skinType, err
+}
+
+func (s *SQLStore) Create(skinType SkinType) (SkinType, error) {
+	result, err := s.db.Exec("INSERT INTO Skin_Type (Skin_Type, Is_Wildcard) VALUES (?, ?)", skinType.SkinType, skinType.Wildcard)
+	if err != nil {
+		return SkinType{}, err
+	}
+	// The ID is assigned by the database
+	id, err := result.LastInsertId()
+	if err != nil {
+		return SkinType{}, err
+	}
+	return s.Get(int(id))
+}
+
+func (s *SQLStore) Update(skinType SkinType) (SkinType, error) {
+	result, err := s.db.Exec("UPDATE Skin_Type SET Skin_Type = ?, Is_Wildcard = ? WHERE Skin_Type_ID = ?",
+		skinType.SkinType, skinType.Wildcard, skinType.SkinTypeID)
+	if err != nil {
+		return SkinType{}, err
+	}
+	if err := database.RequireRow(result); err != nil {
+		return SkinType{}, err
+	}
+	// Return the row as stored rather than as sent
+	return s.Get(skinType.SkinTypeID)
+}
+
+func (s *SQLStore) Delete(id int) error {
+	result, err := s.db.Exec("DELETE FROM Skin_Type WHERE Skin_Type_ID = ?", id)
+	if err != nil {
+		return err
+	}
+	return database.RequireRow(result)
+}
+
+// MemoryStore keeps skin types in process memory. It is safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	rows   map[int]SkinType
+	lastID int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{rows: make(map[int]SkinType)}
+}
+
+func (s *MemoryStore) List() ([]SkinType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var skinTypes []SkinType
+	for _, skinType := range s.rows {
+		skinTypes = append(skinTypes, skinType)
+	}
+	sort.Slice(skinTypes, func(i, j int) bool { return skinTypes[i].SkinTypeID < skinTypes[j].SkinTypeID })
+	return skinTypes, nil
+}
+
+func (s *MemoryStore) Get(id int) (SkinType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	skinType, ok := s.rows[id]
+	if !ok {
+		return SkinType{}, sql.ErrNoRows
+	}
+	return skinType, nil
+}
+
+func (s *MemoryStore) Create(skinType SkinType) (SkinType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	skinType.SkinTypeID = s.lastID
+	s.rows[skinType.SkinTypeID] = skinType
+	return skinType, nil
+}
+
+func (s *MemoryStore) Update(skinType SkinType) (SkinType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[skinType.SkinTypeID]; !ok {
+		return SkinType{}, sql.ErrNoRows
+	}
+	s.rows[skinType.SkinTypeID] = skinType
+	return skinType, nil
+}
+
+func (s *MemoryStore) Delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rows[id]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(s.rows, id)
+	return nil
+}
@@ -0,0 +1,27 @@
+package skin_type
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Validation"
+)
+
+// Width of the Skin_Type column
+const maxNameLength = 100
+
+// Validate checks a skin type before it is written and returns every problem
+// found, or nil. New rows are numbered by the database, so the skin_type_id
+// is only checked by ValidateReplacement.
+func Validate(skinType SkinType) []api_error.FieldError {
+	var v validation.Validator
+	v.Required("skin_type", skinType.SkinType)
+	v.MaxLength("skin_type", skinType.SkinType, maxNameLength)
+	return v.Errors()
+}
+
+// ValidateReplacement checks a skin type sent to replace a stored row,
+// which must also name the row's skin_type_id.
+func ValidateReplacement(skinType SkinType) []api_error.FieldError {
+	var v validation.Validator
+	v.Positive("skin_type_id", skinType.SkinTypeID)
+	return append(v.Errors(), Validate(skinType)...)
+}
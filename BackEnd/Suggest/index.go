@@ -0,0 +1,169 @@
+package suggest
+
+import (
+	"BackEnd/Brand"
+	"BackEnd/Key_Ingredients"
+	"BackEnd/Products"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Sources are the stores the index is built from.
+type Sources struct {
+	Brands         brand.Store
+	KeyIngredients key_ingredients.Store
+	Products       products.Store
+}
+
+// entry is one way of reaching a suggestion: key is the lower-cased text from
+// the start of one of its words to the end.
+type entry struct {
+	key        string
+	suggestion Suggestion
+	wordStart  bool // key starts part-way through the text
+}
+
+// Index answers prefix queries from memory. It is rebuilt as a whole by
+// Refresh, which is cheap at the size of the catalog. It is safe for
+// concurrent use.
+type Index struct {
+	sources Sources
+
+	mu      sync.RWMutex
+	entries []entry // sorted by key
+	built   bool
+}
+
+func NewIndex(sources Sources) *Index {
+	return &Index{sources: sources}
+}
+
+// Refresh rebuilds the index from the stores.
+func (ix *Index) Refresh() error {
+	var suggestions []Suggestion
+	brands, err := ix.sources.Brands.List()
+	if err != nil {
+		return err
+	}
+	for _, b := range brands {
+		suggestions = append(suggestions, Suggestion{Type: TypeBrand, ID: b.BrandID, Text: b.Brand})
+	}
+	ingredients, err := ix.sources.KeyIngredients.List()
+	if err != nil {
+		return err
+	}
+	for _, k := range ingredients {
+		suggestions = append(suggestions, Suggestion{Type: TypeKeyIngredient, ID: k.KeyIngredientsID, Text: k.KeyIngredient})
+	}
+	catalog, err := ix.sources.Products.List()
+	if err != nil {
+		return err
+	}
+	for _, p := range catalog {
+		suggestions = append(suggestions, Suggestion{Type: TypeProduct, ID: p.ProductID, Text: p.ProductName})
+	}
+
+	var entries []entry
+	for _, suggestion := range suggestions {
+		text := strings.ToLower(strings.TrimSpace(suggestion.Text))
+		for i, r := range text {
+			previous, _ := utf8.DecodeLastRuneInString(text[:i])
+			if isWordRune(r) && (i == 0 || !isWordRune(previous)) {
+				entries = append(entries, entry{key: text[i:], suggestion: suggestion, wordStart: i > 0})
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	ix.mu.Lock()
+	ix.entries = entries
+	ix.built = true
+	ix.mu.Unlock()
+	return nil
+}
+
+// Lookup returns up to limit suggestions of the given types with a word
+// starting with prefix. Whole-text prefixes rank before matches on a later
+// word, then shorter texts before longer ones. Suggestions with the same type
+// and text are returned once.
+func (ix *Index) Lookup(prefix string, types []string, limit int) ([]Suggestion, error) {
+	ix.mu.RLock()
+	built := ix.built
+	ix.mu.RUnlock()
+	if !built {
+		if err := ix.Refresh(); err != nil {
+			return nil, err
+		}
+	}
+
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	wanted := make(map[string]bool)
+	for _, t := range types {
+		wanted[t] = true
+	}
+
+	ix.mu.RLock()
+	var found []entry
+	for i := sort.Search(len(ix.entries), func(i int) bool { return ix.entries[i].key >= prefix }); i < len(ix.entries); i++ {
+		if !strings.HasPrefix(ix.entries[i].key, prefix) {
+			break
+		}
+		if wanted[ix.entries[i].suggestion.Type] {
+			found = append(found, ix.entries[i])
+		}
+	}
+	ix.mu.RUnlock()
+
+	sort.SliceStable(found, func(i, j int) bool {
+		a, b := found[i], found[j]
+		if a.wordStart != b.wordStart {
+			return !a.wordStart
+		}
+		if len(a.suggestion.Text) != len(b.suggestion.Text) {
+			return len(a.suggestion.Text) < len(b.suggestion.Text)
+		}
+		if a.suggestion.Type != b.suggestion.Type {
+			return typeRank[a.suggestion.Type] < typeRank[b.suggestion.Type]
+		}
+		return a.suggestion.ID < b.suggestion.ID
+	})
+
+	suggestions := []Suggestion{}
+	seen := make(map[string]bool)
+	for _, e := range found {
+		key := e.suggestion.Type + "\x00" + strings.ToLower(e.suggestion.Text)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		suggestions = append(suggestions, e.suggestion)
+		if len(suggestions) == limit {
+			break
+		}
+	}
+	return suggestions, nil
+}
+
+// RefreshAfterWrite rebuilds the index once a request that changes the
+// catalog has succeeded, so suggestions never lag behind the API.
+func (ix *Index) RefreshAfterWrite() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if c.Request.Method == http.MethodGet || c.Writer.Status() >= 300 {
+			return
+		}
+		if err := ix.Refresh(); err != nil {
+			log.Printf("Refreshing the suggestion index failed: %v", err)
+		}
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
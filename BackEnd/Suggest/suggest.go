@@ -0,0 +1,87 @@
+package suggest
+
+import (
+	"BackEnd/Api_Error"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Suggestion types, also accepted by the types query parameter
+const (
+	TypeProduct       = "product"
+	TypeBrand         = "brand"
+	TypeKeyIngredient = "key_ingredient"
+)
+
+// typeRank orders suggestions of different types that otherwise tie.
+var typeRank = map[string]int{TypeBrand: 0, TypeKeyIngredient: 1, TypeProduct: 2}
+
+// Limits on suggestion queries
+const (
+	defaultLimit   = 10
+	maxLimit       = 50
+	maxQueryLength = 100
+)
+
+// Suggestion is one completion for the search box.
+type Suggestion struct {
+	Type string `json:"type"`
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+// Get suggestions for a partly typed query. types takes a comma-separated
+// list of product, brand and key_ingredient and defaults to all of them.
+func GetSuggestions(c *gin.Context, index *Index) {
+	var fields []api_error.FieldError
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" || len(q) > maxQueryLength {
+		fields = append(fields, api_error.FieldError{
+			Field:   "q",
+			Code:    api_error.Invalid,
+			Message: fmt.Sprintf("q must be between 1 and %d characters", maxQueryLength),
+		})
+	}
+	types := []string{TypeProduct, TypeBrand, TypeKeyIngredient}
+	if raw, ok := c.GetQuery("types"); ok {
+		types = nil
+		for _, t := range strings.Split(raw, ",") {
+			t = strings.TrimSpace(t)
+			if _, known := typeRank[t]; !known {
+				fields = append(fields, api_error.FieldError{
+					Field:   "types",
+					Code:    api_error.Invalid,
+					Message: "Unknown type " + t,
+				})
+				continue
+			}
+			types = append(types, t)
+		}
+	}
+	limit := defaultLimit
+	if raw, ok := c.GetQuery("limit"); ok {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxLimit {
+			fields = append(fields, api_error.FieldError{
+				Field:   "limit",
+				Code:    api_error.Invalid,
+				Message: fmt.Sprintf("limit must be between 1 and %d", maxLimit),
+			})
+		}
+	}
+	if fields != nil {
+		api_error.Respond(c, api_error.BadRequest("Invalid query parameters", fields...))
+		return
+	}
+
+	suggestions, err := index.Lookup(q, types, limit)
+	if err != nil {
+		api_error.Respond(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"data": suggestions})
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"BackEnd/Api_Error"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator collects every problem with an entity so they can be reported
+// together. The zero value is ready to use.
+type Validator struct {
+	fields []api_error.FieldError
+}
+
+// Add records a problem with field.
+func (v *Validator) Add(field, code, message string) {
+	v.fields = append(v.fields, api_error.FieldError{Field: field, Code: code, Message: message})
+}
+
+// Errors returns the problems found so far, or nil if there were none.
+func (v *Validator) Errors() []api_error.FieldError {
+	return v.fields
+}
+
+// Positive requires a positive ID.
+func (v *Validator) Positive(field string, value int) {
+	if value <= 0 {
+		v.Add(field, api_error.Invalid, field+" must be a positive integer")
+	}
+}
+
+// Required requires a string with at least one non-space character.
+func (v *Validator) Required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.Add(field, api_error.Required, field+" is required")
+	}
+}
+
+// MaxLength limits a string to the character width of its nvarchar column.
+func (v *Validator) MaxLength(field, value string, max int) {
+	if utf8.RuneCountInString(value) > max {
+		v.Add(field, api_error.TooLong, fmt.Sprintf("%s must be at most %d characters", field, max))
+	}
+}
+
+// URL requires an absolute http or https URL when value is set.
+func (v *Validator) URL(field, value string) {
+	if value == "" {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.Add(field, api_error.InvalidURL, field+" must be an absolute http or https URL")
+	}
+}
+
+// OneOf requires value to be one of allowed.
+func (v *Validator) OneOf(field, value string, allowed ...string) {
+	for _, candidate := range allowed {
+		if value == candidate {
+			return
+		}
+	}
+	v.Add(field, api_error.Invalid, fmt.Sprintf("%s must be one of %q", field, allowed))
+}
+
+// Reference requires id to name an existing row, looked up with get, which
+// reports sql.ErrNoRows for an unknown ID. Any other lookup failure is returned.
+func (v *Validator) Reference(field string, id int, get func(id int) error) error {
+	if id <= 0 {
+		v.Add(field, api_error.Required, field+" is required")
+		return nil
+	}
+	err := get(id)
+	if err == sql.ErrNoRows {
+		v.Add(field, api_error.UnknownReference, fmt.Sprintf("%s %d does not exist", field, id))
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"BackEnd/Database"
+	"BackEnd/Ingredient_Attribute"
+	"BackEnd/Products"
+	"path/filepath"
+	"testing"
+)
+
+// newTestSQLStores migrates a fresh SQLite database and prepares its stores
+// the way main does before serving.
+func newTestSQLStores(t *testing.T) Stores {
+	t.Helper()
+	db, err := database.OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := database.Migrate(db, database.SQLite); err != nil {
+		t.Fatal(err)
+	}
+	stores := newSQLStores(db, database.SQLite)
+	if err := stores.Products.SyncIngredients(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ingredient_attribute.SyncNames(stores.Attributes, stores.Normalizer); err != nil {
+		t.Fatal(err)
+	}
+	return stores
+}
+
+// newTestMemoryStores returns memory stores that know Alcohol Denat. is a
+// drying alcohol, spelt as a label would spell it.
+func newTestMemoryStores(t *testing.T) Stores {
+	t.Helper()
+	stores := newMemoryStores()
+	_, err := stores.Attributes.Create(ingredient_attribute.IngredientAttribute{Ingredient: "Alcohol Denat.", DryingAlcohol: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stores
+}
+
+func hasBadge(product products.Product, name string) bool {
+	for _, badge := range product.Badges {
+		if badge == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAlcoholDenatCostsAlcoholFree(t *testing.T) {
+	for name, newStores := range map[string]func(*testing.T) Stores{"sql": newTestSQLStores, "memory": newTestMemoryStores} {
+		t.Run(name, func(t *testing.T) {
+			stores := newStores(t)
+			product := products.Product{
+				ProductName:      "Toner",
+				AllIngredients:   "Water, Alcohol Denat., Glycerin",
+				ConcernID:        1,
+				SkinTypeID:       1,
+				BrandID:          1,
+				ProductTypeID:    1,
+				KeyIngredientsID: 1,
+			}
+			created, err := stores.Products.Create(product)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hasBadge(created, "alcohol-free") {
+				t.Errorf("badges = %q; a product listing Alcohol Denat. is not alcohol-free", created.Badges)
+			}
+
+			product.AllIngredients = "Water, Glycerin"
+			plain, err := stores.Products.Create(product)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !hasBadge(plain, "alcohol-free") {
+				t.Errorf("badges = %q; want alcohol-free without a drying alcohol", plain.Badges)
+			}
+
+			filtered, _, err := stores.Products.Page(products.PageQuery{Filter: products.Filter{
+				ProductIDs:  []int{created.ProductID, plain.ProductID},
+				Ingredients: products.IngredientFilter{Badges: []string{"alcohol-free"}},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(filtered) != 1 || filtered[0].ProductID != plain.ProductID {
+				t.Errorf("filtering on alcohol-free found %d products; want only product %d", len(filtered), plain.ProductID)
+			}
+		})
+	}
+}
+
+func TestBadgesNeedEveryIngredientKnown(t *testing.T) {
+	stores := newTestSQLStores(t)
+	// The benzoyl peroxide cleansers list ingredients the seeded table does not know
+	for _, id := range []int{1, 3} {
+		product, err := stores.Products.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, badge := range []string{"allergen-free", "non-comedogenic", "pregnancy-safe"} {
+			if hasBadge(product, badge) {
+				t.Errorf("product %d badges = %q; want no %s", id, product.Badges, badge)
+			}
+		}
+	}
+
+	rating := 0
+	_, err := stores.Attributes.Create(ingredient_attribute.IngredientAttribute{Ingredient: "Water", Comedogenic: &rating})
+	if err != nil {
+		t.Fatal(err)
+	}
+	product, err := stores.Products.Create(products.Product{
+		ProductName:      "Mist",
+		AllIngredients:   "Water, Glycerin",
+		ConcernID:        1,
+		SkinTypeID:       1,
+		BrandID:          1,
+		ProductTypeID:    1,
+		KeyIngredientsID: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, badge := range []string{"allergen-free", "non-comedogenic", "pregnancy-safe"} {
+		if !hasBadge(product, badge) {
+			t.Errorf("badges = %q; want %s once every ingredient is known and rated", product.Badges, badge)
+		}
+	}
+
+	filtered, _, err := stores.Products.Page(products.PageQuery{Filter: products.Filter{
+		Ingredients: products.IngredientFilter{Badges: []string{"allergen-free", "non-comedogenic"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 1 || filtered[0].ProductID != product.ProductID {
+		t.Errorf("filtering on allergen-free and non-comedogenic found %d products; want only product %d", len(filtered), product.ProductID)
+	}
+
+	// Retinol is known, and flagged for care during pregnancy
+	retinoid, err := stores.Products.Create(products.Product{
+		ProductName:      "Night Serum",
+		AllIngredients:   "Water, Glycerin, Retinol",
+		ConcernID:        1,
+		SkinTypeID:       1,
+		BrandID:          1,
+		ProductTypeID:    1,
+		KeyIngredientsID: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hasBadge(retinoid, "pregnancy-safe") {
+		t.Errorf("badges = %q; a product listing retinol is not pregnancy-safe", retinoid.Badges)
+	}
+	filtered, _, err = stores.Products.Page(products.PageQuery{Filter: products.Filter{
+		ProductIDs:  []int{product.ProductID, retinoid.ProductID},
+		Ingredients: products.IngredientFilter{Badges: []string{"pregnancy-safe"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 1 || filtered[0].ProductID != product.ProductID {
+		t.Errorf("filtering on pregnancy-safe found %d products; want only product %d", len(filtered), product.ProductID)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"BackEnd/Database"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// runMigrateCommand implements the migrate subcommand:
+//
+//	migrate [up]       apply every pending migration
+//	migrate down [n]   revert the newest n migrations (default 1)
+//	migrate version    print the current schema version
+//	migrate check      compare the schema with the columns the code uses
+func runMigrateCommand(db *sql.DB, dialect database.Dialect, args []string) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+	switch command {
+	case "up":
+		applied, err := database.Migrate(db, dialect)
+		if err != nil {
+			return err
+		}
+		log.Printf("Applied %d migration(s)", len(applied))
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid step count %q", args[1])
+			}
+			steps = n
+		}
+		reverted, err := database.Rollback(db, dialect, steps)
+		if err != nil {
+			return err
+		}
+		log.Printf("Reverted %d migration(s)", len(reverted))
+	case "version":
+	case "check":
+		if err := database.CheckSchema(db, dialect, schemaExpectations()); err != nil {
+			return err
+		}
+		log.Printf("Schema matches the code")
+	default:
+		return fmt.Errorf("unknown migrate command %q (want up, down, version or check)", command)
+	}
+	version, err := database.Version(db, dialect)
+	if err != nil {
+		return err
+	}
+	log.Printf("Database schema at migration %04d", version)
+	return nil
+}
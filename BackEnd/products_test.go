@@ -0,0 +1,120 @@
+package main
+
+import (
+	"BackEnd/Concern"
+	"BackEnd/Products"
+	"reflect"
+	"testing"
+)
+
+// seedFilterCatalog adds two products for a concern of their own and returns
+// the concern's ID and the products, a barrier cream linked to niacinamide
+// as a second key ingredient and a fragranced niacinamide gel. Link lists are
+// given in full, as the handlers leave them for the store.
+func seedFilterCatalog(t *testing.T, stores Stores) (int, products.Product, products.Product) {
+	t.Helper()
+	c, err := stores.Concerns.Create(concern.Concern{Concern: "Eczema"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cream, err := stores.Products.Create(products.Product{
+		ProductName:      "Barrier Cream",
+		AllIngredients:   "Water, Ceramide NP, Niacinamide 4%",
+		ConcernID:        c.ConcernID,
+		ConcernIDs:       []int{c.ConcernID},
+		SkinTypeID:       1,
+		SkinTypeIDs:      []int{1},
+		BrandID:          1,
+		ProductTypeID:    4,
+		KeyIngredientsID: 6,
+		KeyIngredientIDs: []int{6, 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gel, err := stores.Products.Create(products.Product{
+		ProductName:      "Calm Gel",
+		AllIngredients:   "Water, Niacinamide 10%, Fragrance",
+		ConcernID:        c.ConcernID,
+		ConcernIDs:       []int{c.ConcernID},
+		SkinTypeID:       1,
+		SkinTypeIDs:      []int{1},
+		BrandID:          1,
+		ProductTypeID:    2,
+		KeyIngredientsID: 2,
+		KeyIngredientIDs: []int{2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.ConcernID, cream, gel
+}
+
+func TestPageFilters(t *testing.T) {
+	for name, newStores := range map[string]func(*testing.T) Stores{"sql": newTestSQLStores, "memory": newTestMemoryStores} {
+		t.Run(name, func(t *testing.T) {
+			stores := newStores(t)
+			concernID, cream, gel := seedFilterCatalog(t, stores)
+			five := 5.0
+			tests := []struct {
+				name   string
+				filter products.Filter
+				want   []int
+			}{
+				{"concern", products.Filter{}, []int{cream.ProductID, gel.ProductID}},
+				{"name", products.Filter{Name: "GEL"}, []int{gel.ProductID}},
+				{"product type", products.Filter{ProductTypeIDs: []int{4}}, []int{cream.ProductID}},
+				{"linked key ingredient", products.Filter{KeyIngredientIDs: []int{2}}, []int{cream.ProductID, gel.ProductID}},
+				{"included ingredient", products.Filter{Ingredients: products.IngredientFilter{Include: []string{"Ceramide NP"}}},
+					[]int{cream.ProductID}},
+				{"minimum concentration", products.Filter{Ingredients: products.IngredientFilter{
+					Include: []string{"niacinamide"}, MinConcentration: &five}}, []int{gel.ProductID}},
+				{"excluded ingredient", products.Filter{Ingredients: products.IngredientFilter{Exclude: []string{"Fragrance"}}},
+					[]int{cream.ProductID}},
+				{"every field", products.Filter{Name: "cream", KeyIngredientIDs: []int{6}, Ingredients: products.IngredientFilter{
+					Exclude: []string{"Fragrance"}}}, []int{cream.ProductID}},
+				{"nothing matches", products.Filter{BrandIDs: []int{2}}, []int{}},
+			}
+			for _, test := range tests {
+				test.filter.ConcernIDs = []int{concernID}
+				found, total, err := stores.Products.Page(products.PageQuery{Filter: test.filter})
+				if err != nil {
+					t.Fatalf("%s: %v", test.name, err)
+				}
+				if got := productIDs(found); !reflect.DeepEqual(got, test.want) || total != len(test.want) {
+					t.Errorf("%s: found %v of %d; want %v", test.name, got, total, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPageSortsAndPages(t *testing.T) {
+	for name, newStores := range map[string]func(*testing.T) Stores{"sql": newTestSQLStores, "memory": newTestMemoryStores} {
+		t.Run(name, func(t *testing.T) {
+			stores := newStores(t)
+			concernID, cream, gel := seedFilterCatalog(t, stores)
+			filter := products.Filter{ConcernIDs: []int{concernID}}
+			tests := []struct {
+				name  string
+				query products.PageQuery
+				want  []int
+			}{
+				{"first page", products.PageQuery{Filter: filter, Sort: "name", Limit: 1}, []int{cream.ProductID}},
+				{"second page", products.PageQuery{Filter: filter, Sort: "name", Limit: 1, Offset: 1}, []int{gel.ProductID}},
+				{"past the end", products.PageQuery{Filter: filter, Sort: "name", Limit: 1, Offset: 2}, []int{}},
+				{"descending", products.PageQuery{Filter: filter, Sort: "name", Descending: true}, []int{gel.ProductID, cream.ProductID}},
+				{"by type", products.PageQuery{Filter: filter, Sort: "type"}, []int{gel.ProductID, cream.ProductID}},
+			}
+			for _, test := range tests {
+				found, total, err := stores.Products.Page(test.query)
+				if err != nil {
+					t.Fatalf("%s: %v", test.name, err)
+				}
+				if got := productIDs(found); !reflect.DeepEqual(got, test.want) || total != 2 {
+					t.Errorf("%s: found %v of %d; want %v of 2", test.name, got, total, test.want)
+				}
+			}
+		})
+	}
+}
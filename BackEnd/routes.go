@@ -0,0 +1,466 @@
+package main
+
+import (
+	"BackEnd/Api_Error"
+	"BackEnd/Brand"
+	"BackEnd/Concern"
+	"BackEnd/Condition_Concern"
+	"BackEnd/Database"
+	"BackEnd/Idempotency"
+	"BackEnd/Ingredient_Attribute"
+	"BackEnd/Ingredient_Interaction"
+	"BackEnd/Ingredient_Synonym"
+	"BackEnd/Key_Ingredients"
+	"BackEnd/Product_Type"
+	"BackEnd/Products"
+	"BackEnd/Recommendation"
+	"BackEnd/Routine"
+	"BackEnd/Skin_Type"
+	"BackEnd/Suggest"
+	"database/sql"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// Stores bundles the repository behind every entity the API serves.
+type Stores struct {
+	Brands         brand.Store
+	Concerns       concern.Store
+	SkinTypes      skin_type.Store
+	ProductTypes   product_type.Store
+	KeyIngredients key_ingredients.Store
+	Products       products.Store
+	Synonyms       ingredient_synonym.Store
+	Conditions     condition_concern.Store
+	Interactions   ingredient_interaction.Store
+	Attributes     ingredient_attribute.Store
+	Idempotency    idempotency.Store
+	// Normalizer names ingredients from the synonym table. The products
+	// store uses it for parsed ingredient lists.
+	Normalizer *ingredient_synonym.Normalizer
+}
+
+// newSQLStores backs every entity with its table in db.
+func newSQLStores(db *sql.DB, dialect database.Dialect) Stores {
+	stores := Stores{
+		Brands:         brand.NewSQLStore(db),
+		Concerns:       concern.NewSQLStore(db),
+		SkinTypes:      skin_type.NewSQLStore(db),
+		ProductTypes:   product_type.NewSQLStore(db),
+		KeyIngredients: key_ingredients.NewSQLStore(db),
+		Synonyms:       ingredient_synonym.NewSQLStore(db),
+		Conditions:     condition_concern.NewSQLStore(db),
+		Interactions:   ingredient_interaction.NewSQLStore(db),
+		Attributes:     ingredient_attribute.NewSQLStore(db),
+		Idempotency:    idempotency.NewSQLStore(db),
+	}
+	stores.Normalizer = ingredient_synonym.NewNormalizer(stores.Synonyms)
+	stores.Products = products.NewSQLStore(db, dialect, stores.Normalizer)
+	return stores
+}
+
+// schemaExpectations lists the columns every SQL store depends on, for the
+// drift check run before serving.
+func schemaExpectations() []database.Expectation {
+	expectations := []database.Expectation{
+		{Table: brand.Table, Columns: brand.Columns},
+		{Table: concern.Table, Columns: concern.Columns},
+		{Table: skin_type.Table, Columns: skin_type.Columns},
+		{Table: product_type.Table, Columns: product_type.Columns},
+		{Table: key_ingredients.Table, Columns: key_ingredients.Columns},
+		{Table: products.Table, Columns: products.Columns},
+		{Table: products.IngredientTable, Columns: products.IngredientColumns},
+		{Table: ingredient_synonym.Table, Columns: ingredient_synonym.Columns},
+		{Table: condition_concern.Table, Columns: condition_concern.Columns},
+		{Table: ingredient_interaction.Table, Columns: ingredient_interaction.Columns},
+		{Table: ingredient_attribute.Table, Columns: ingredient_attribute.Columns},
+		{Table: idempotency.Table, Columns: idempotency.Columns},
+	}
+	return append(expectations, products.LinkExpectations()...)
+}
+
+// newMemoryStores backs every entity with an empty in-process store, which
+// lets the full API run without a database server.
+func newMemoryStores() Stores {
+	stores := Stores{
+		Brands:         brand.NewMemoryStore(),
+		Concerns:       concern.NewMemoryStore(),
+		SkinTypes:      skin_type.NewMemoryStore(),
+		ProductTypes:   product_type.NewMemoryStore(),
+		KeyIngredients: key_ingredients.NewMemoryStore(),
+		Synonyms:       ingredient_synonym.NewMemoryStore(),
+		Conditions:     condition_concern.NewMemoryStore(),
+		Interactions:   ingredient_interaction.NewMemoryStore(),
+		Attributes:     ingredient_attribute.NewMemoryStore(),
+		Idempotency:    idempotency.NewMemoryStore(),
+	}
+	stores.Normalizer = ingredient_synonym.NewNormalizer(stores.Synonyms)
+	stores.Products = products.NewMemoryStore(stores.Brands, stores.Concerns, stores.SkinTypes, stores.KeyIngredients,
+		stores.Attributes, stores.Normalizer)
+	return stores
+}
+
+// newRouter registers every route against the given stores. ping reports
+// whether the backing storage is reachable and drives /health.
+func newRouter(stores Stores, ping func() error) *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Logger(), api_error.RequestIDMiddleware(), api_error.Recovery())
+
+	// CORS configuration
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowAllOrigins = true
+	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Request-ID", idempotency.Header}
+	corsConfig.ExposeHeaders = []string{"Deprecation", "Warning", "X-Request-ID", "Location", idempotency.ReplayedHeader}
+	router.Use(cors.New(corsConfig))
+
+	// Unknown routes and methods get the error envelope too
+	router.HandleMethodNotAllowed = true
+	router.NoRoute(func(c *gin.Context) {
+		api_error.Respond(c, api_error.NotFound("No route for "+c.Request.URL.Path))
+	})
+	router.NoMethod(func(c *gin.Context) {
+		api_error.Respond(c, &api_error.Error{
+			Status:  http.StatusMethodNotAllowed,
+			Code:    api_error.CodeInvalidRequest,
+			Message: c.Request.Method + " is not allowed on " + c.Request.URL.Path,
+		})
+	})
+
+	// Add basic health check endpoint
+	router.GET("/health", func(c *gin.Context) {
+		err := ping()
+		if err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"status":  "error",
+				"message": "Database connection failed",
+			})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "ok",
+			"message": "Service is healthy",
+		})
+	})
+
+	// Creates sent with an Idempotency-Key are safe to retry
+	idempotent := idempotency.Middleware(stores.Idempotency)
+
+	// Suggestions come from an in-process index of brand, key ingredient and
+	// product names, rebuilt after every successful write to those tables
+	suggestions := suggest.NewIndex(suggest.Sources{
+		Brands:         stores.Brands,
+		KeyIngredients: stores.KeyIngredients,
+		Products:       stores.Products,
+	})
+	refresh := suggestions.RefreshAfterWrite()
+	router.GET("/suggest", func(c *gin.Context) {
+		suggest.GetSuggestions(c, suggestions)
+	})
+
+	// Brand CRUD routes
+	router.GET("/brand", func(c *gin.Context) {
+		brand.GetBrands(c, stores.Brands)
+	})
+	router.GET("/brand/:brand_id", func(c *gin.Context) {
+		brand.GetBrand(c, stores.Brands)
+	})
+	router.POST("/brand/create", idempotent, refresh, func(c *gin.Context) {
+		brand.CreateBrand(c, stores.Brands)
+	})
+	router.PUT("/brand/update", refresh, func(c *gin.Context) {
+		brand.UpdateBrand(c, stores.Brands)
+	})
+	router.PATCH("/brand/:brand_id", refresh, func(c *gin.Context) {
+		brand.PatchBrand(c, stores.Brands)
+	})
+	router.DELETE("/brand/delete/:brand_id", refresh, func(c *gin.Context) {
+		brand.DeleteBrand(c, stores.Brands)
+	})
+
+	// Concern CRUD routes
+	router.GET("/concerns", func(c *gin.Context) {
+		concern.GetConcerns(c, stores.Concerns)
+	})
+	router.GET("/concerns/:concern_id", func(c *gin.Context) {
+		concern.GetConcern(c, stores.Concerns)
+	})
+	router.POST("/concerns/create", idempotent, func(c *gin.Context) {
+		concern.CreateConcern(c, stores.Concerns)
+	})
+	router.PUT("/concerns/update", func(c *gin.Context) {
+		concern.UpdateConcern(c, stores.Concerns)
+	})
+	router.PATCH("/concerns/:concern_id", func(c *gin.Context) {
+		concern.PatchConcern(c, stores.Concerns)
+	})
+	router.DELETE("/concerns/delete/:concern_id", func(c *gin.Context) {
+		concern.DeleteConcern(c, stores.Concerns)
+	})
+
+	// Skin Type CRUD routes
+	router.GET("/skin_type", func(c *gin.Context) {
+		skin_type.GetSkinTypes(c, stores.SkinTypes)
+	})
+	router.GET("/skin_type/:skin_type_id", func(c *gin.Context) {
+		skin_type.GetSkinType(c, stores.SkinTypes)
+	})
+	router.POST("/skin_type/create", idempotent, func(c *gin.Context) {
+		skin_type.CreateSkinType(c, stores.SkinTypes)
+	})
+	router.PUT("/skin_type/update", func(c *gin.Context) {
+		skin_type.UpdateSkinType(c, stores.SkinTypes)
+	})
+	router.PATCH("/skin_type/:skin_type_id", func(c *gin.Context) {
+		skin_type.PatchSkinType(c, stores.SkinTypes)
+	})
+	router.DELETE("/skin_type/delete/:skin_type_id", func(c *gin.Context) {
+		skin_type.DeleteSkinType(c, stores.SkinTypes)
+	})
+
+	// Product Type CRUD routes
+	router.GET("/product_type", func(c *gin.Context) {
+		product_type.GetProductTypes(c, stores.ProductTypes)
+	})
+	router.GET("/product_type/:product_type_id", func(c *gin.Context) {
+		product_type.GetProductType(c, stores.ProductTypes)
+	})
+	router.POST("/product_type/create", idempotent, func(c *gin.Context) {
+		product_type.CreateProductType(c, stores.ProductTypes)
+	})
+	router.PUT("/product_type/update", func(c *gin.Context) {
+		product_type.UpdateProductType(c, stores.ProductTypes)
+	})
+	router.PATCH("/product_type/:product_type_id", func(c *gin.Context) {
+		product_type.PatchProductType(c, stores.ProductTypes)
+	})
+	router.DELETE("/product_type/delete/:product_type_id", func(c *gin.Context) {
+		product_type.DeleteProductType(c, stores.ProductTypes)
+	})
+
+	// Key Ingredients CRUD routes
+	router.GET("/key_ingredients", func(c *gin.Context) {
+		key_ingredients.GetKeyIngredients(c, stores.KeyIngredients)
+	})
+	router.GET("/key_ingredients/:key_ingredients_id", func(c *gin.Context) {
+		key_ingredients.GetKeyIngredient(c, stores.KeyIngredients)
+	})
+	router.POST("/key_ingredients/create", idempotent, refresh, func(c *gin.Context) {
+		key_ingredients.CreateKeyIngredient(c, stores.KeyIngredients)
+	})
+	router.PUT("/key_ingredients/update", refresh, func(c *gin.Context) {
+		key_ingredients.UpdateKeyIngredient(c, stores.KeyIngredients)
+	})
+	router.PATCH("/key_ingredients/:key_ingredients_id", refresh, func(c *gin.Context) {
+		key_ingredients.PatchKeyIngredient(c, stores.KeyIngredients)
+	})
+	router.DELETE("/key_ingredients/delete/:key_ingredients_id", refresh, func(c *gin.Context) {
+		key_ingredients.DeleteKeyIngredient(c, stores.KeyIngredients)
+	})
+
+	// Ingredient synonym CRUD routes. The normalizer maps free-text names onto
+	// canonical ones and reloads after every successful change to the table,
+	// after which the parsed product ingredients are renamed to match.
+	normalizer := stores.Normalizer
+	reload := normalizer.RefreshAfterWrite()
+	reparse := products.SyncAfterWrite(stores.Products)
+	router.GET("/ingredient_synonyms", func(c *gin.Context) {
+		ingredient_synonym.GetSynonyms(c, stores.Synonyms)
+	})
+	router.GET("/ingredient_synonyms/normalize", func(c *gin.Context) {
+		ingredient_synonym.NormalizeNames(c, normalizer)
+	})
+	router.GET("/ingredient_synonyms/:synonym_id", func(c *gin.Context) {
+		ingredient_synonym.GetSynonym(c, stores.Synonyms)
+	})
+	router.POST("/ingredient_synonyms/create", idempotent, reparse, reload, func(c *gin.Context) {
+		ingredient_synonym.CreateSynonym(c, stores.Synonyms)
+	})
+	router.PUT("/ingredient_synonyms/update", reparse, reload, func(c *gin.Context) {
+		ingredient_synonym.UpdateSynonym(c, stores.Synonyms)
+	})
+	router.PATCH("/ingredient_synonyms/:synonym_id", reparse, reload, func(c *gin.Context) {
+		ingredient_synonym.PatchSynonym(c, stores.Synonyms)
+	})
+	router.DELETE("/ingredient_synonyms/delete/:synonym_id", reparse, reload, func(c *gin.Context) {
+		ingredient_synonym.DeleteSynonym(c, stores.Synonyms)
+	})
+
+	// Condition mapping CRUD routes. Each label the skin analysis model
+	// reports maps to the concern recommendations are selected for.
+	router.GET("/condition_concerns", func(c *gin.Context) {
+		condition_concern.GetConditions(c, stores.Conditions)
+	})
+	router.GET("/condition_concerns/:condition_id", func(c *gin.Context) {
+		condition_concern.GetCondition(c, stores.Conditions)
+	})
+	router.POST("/condition_concerns/create", idempotent, func(c *gin.Context) {
+		condition_concern.CreateCondition(c, stores.Conditions, stores.Concerns)
+	})
+	router.PUT("/condition_concerns/update", func(c *gin.Context) {
+		condition_concern.UpdateCondition(c, stores.Conditions, stores.Concerns)
+	})
+	router.PATCH("/condition_concerns/:condition_id", func(c *gin.Context) {
+		condition_concern.PatchCondition(c, stores.Conditions, stores.Concerns)
+	})
+	router.DELETE("/condition_concerns/delete/:condition_id", func(c *gin.Context) {
+		condition_concern.DeleteCondition(c, stores.Conditions)
+	})
+
+	// Recommendations for the conditions detected in a photo
+	sources := recommendation.Sources{
+		Products:     stores.Products,
+		Conditions:   stores.Conditions,
+		SkinTypes:    stores.SkinTypes,
+		ProductTypes: stores.ProductTypes,
+	}
+	router.POST("/recommendations", func(c *gin.Context) {
+		recommendation.PostRecommendations(c, sources)
+	})
+
+	// Ingredient interaction CRUD routes. The rules say which ingredients
+	// conflict, call for caution or work well together in one routine.
+	router.GET("/ingredient_interactions", func(c *gin.Context) {
+		ingredient_interaction.GetInteractions(c, stores.Interactions)
+	})
+	router.GET("/ingredient_interactions/:interaction_id", func(c *gin.Context) {
+		ingredient_interaction.GetInteraction(c, stores.Interactions)
+	})
+	router.POST("/ingredient_interactions/create", idempotent, func(c *gin.Context) {
+		ingredient_interaction.CreateInteraction(c, stores.Interactions)
+	})
+	router.PUT("/ingredient_interactions/update", func(c *gin.Context) {
+		ingredient_interaction.UpdateInteraction(c, stores.Interactions)
+	})
+	router.PATCH("/ingredient_interactions/:interaction_id", func(c *gin.Context) {
+		ingredient_interaction.PatchInteraction(c, stores.Interactions)
+	})
+	router.DELETE("/ingredient_interactions/delete/:interaction_id", func(c *gin.Context) {
+		ingredient_interaction.DeleteInteraction(c, stores.Interactions)
+	})
+
+	// Ingredient attribute CRUD routes. The flags and comedogenic ratings
+	// decide the badges products earn, such as fragrance-free, which the
+	// product endpoints show and filter on.
+	router.GET("/ingredient_attributes", func(c *gin.Context) {
+		ingredient_attribute.GetAttributes(c, stores.Attributes)
+	})
+	router.GET("/ingredient_attributes/:attribute_id", func(c *gin.Context) {
+		ingredient_attribute.GetAttribute(c, stores.Attributes)
+	})
+	router.POST("/ingredient_attributes/create", idempotent, func(c *gin.Context) {
+		ingredient_attribute.CreateAttribute(c, stores.Attributes, stores.Normalizer)
+	})
+	router.PUT("/ingredient_attributes/update", func(c *gin.Context) {
+		ingredient_attribute.UpdateAttribute(c, stores.Attributes, stores.Normalizer)
+	})
+	router.PATCH("/ingredient_attributes/:attribute_id", func(c *gin.Context) {
+		ingredient_attribute.PatchAttribute(c, stores.Attributes, stores.Normalizer)
+	})
+	router.DELETE("/ingredient_attributes/delete/:attribute_id", func(c *gin.Context) {
+		ingredient_attribute.DeleteAttribute(c, stores.Attributes)
+	})
+
+	// Morning and evening routines, one product per routine step, and checks
+	// of routines put together by hand
+	routines := routine.Sources{
+		Products:       stores.Products,
+		ProductTypes:   stores.ProductTypes,
+		KeyIngredients: stores.KeyIngredients,
+		Concerns:       stores.Concerns,
+		SkinTypes:      stores.SkinTypes,
+		Interactions:   stores.Interactions,
+		Normalizer:     stores.Normalizer,
+	}
+	router.GET("/routines/build", func(c *gin.Context) {
+		routine.BuildRoutine(c, routines)
+	})
+	router.POST("/routines/check", func(c *gin.Context) {
+		routine.CheckRoutine(c, routines)
+	})
+
+	// Products CRUD routes
+	refs := products.References{
+		Concerns:       stores.Concerns,
+		SkinTypes:      stores.SkinTypes,
+		Brands:         stores.Brands,
+		ProductTypes:   stores.ProductTypes,
+		KeyIngredients: stores.KeyIngredients,
+	}
+	router.GET("/products", func(c *gin.Context) {
+		products.GetProducts(c, stores.Products, stores.Normalizer)
+	})
+
+	router.GET("/products/select/:concern_id/:skin_type_id", func(c *gin.Context) {
+		concernIDStr := c.Param("concern_id")
+		concernID, err := strconv.Atoi(concernIDStr)
+		if err != nil {
+			api_error.Respond(c, api_error.InvalidParam("concern_id"))
+			return
+		}
+		skinTypeIDStr := c.Param("skin_type_id")
+		skinTypeID, err := strconv.Atoi(skinTypeIDStr)
+		if err != nil {
+			api_error.Respond(c, api_error.InvalidParam("skin_type_id"))
+			return
+		}
+		products.GetSelectProducts(c, stores.Products, stores.Normalizer, concernID, skinTypeID)
+	})
+
+	router.GET("/products/selectspec/:concern_id/:skin_type_id/:product_type_id", func(c *gin.Context) {
+		concernIDStr := c.Param("concern_id")
+		concernID, err := strconv.Atoi(concernIDStr)
+		if err != nil {
+			api_error.Respond(c, api_error.InvalidParam("concern_id"))
+			return
+		}
+
+		skinTypeIDStr := c.Param("skin_type_id")
+		skinTypeID, err := strconv.Atoi(skinTypeIDStr)
+		if err != nil {
+			api_error.Respond(c, api_error.InvalidParam("skin_type_id"))
+			return
+		}
+
+		productTypeIDStr := c.Param("product_type_id")
+		productTypeID, err := strconv.Atoi(productTypeIDStr)
+		if err != nil {
+			api_error.Respond(c, api_error.InvalidParam("product_type_id"))
+			return
+		}
+
+		products.GetSelectProductsByType(c, stores.Products, stores.Normalizer, concernID, skinTypeID, productTypeID)
+	})
+
+	router.GET("/products/search", func(c *gin.Context) {
+		products.SearchProducts(c, stores.Products)
+	})
+	router.GET("/products/compare", func(c *gin.Context) {
+		products.CompareProducts(c, stores.Products, refs, stores.Normalizer)
+	})
+	router.GET("/products/:products_id/ingredients", func(c *gin.Context) {
+		products.GetProductIngredients(c, stores.Products)
+	})
+	router.GET("/products/:products_id/similar", func(c *gin.Context) {
+		products.GetSimilarProducts(c, stores.Products, stores.KeyIngredients, stores.Normalizer)
+	})
+	router.GET("/products/:products_id", func(c *gin.Context) {
+		products.GetProduct(c, stores.Products)
+	})
+	router.POST("/products/create", idempotent, refresh, func(c *gin.Context) {
+		products.CreateProduct(c, stores.Products, refs)
+	})
+	router.PUT("/products/update", refresh, func(c *gin.Context) {
+		products.UpdateProduct(c, stores.Products, refs)
+	})
+	router.PATCH("/products/:products_id", refresh, func(c *gin.Context) {
+		products.PatchProduct(c, stores.Products, refs)
+	})
+	router.DELETE("/products/delete/:products_id", refresh, func(c *gin.Context) {
+		products.DeleteProduct(c, stores.Products)
+	})
+
+	return router
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"BackEnd/Idempotency"
+	"BackEnd/Products"
+	"encoding/json"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestRouter serves the full API from empty memory stores.
+func newTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	return newRouter(newMemoryStores(), func() error { return nil })
+}
+
+// serve sends a request with an optional JSON body and extra headers, given
+// as name and value pairs.
+func serve(router http.Handler, method, path, body string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// decode reads a response body into v, failing the test unless the status
+// is want.
+func decode(t *testing.T, w *httptest.ResponseRecorder, want int, v interface{}) {
+	t.Helper()
+	if w.Code != want {
+		t.Fatalf("status = %d; want %d: %s", w.Code, want, w.Body.String())
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("decoding %s: %v", w.Body.String(), err)
+	}
+}
+
+// errorBody is the error envelope every failure is written in.
+type errorBody struct {
+	Error struct {
+		Code   string `json:"code"`
+		Fields []struct {
+			Field string `json:"field"`
+		} `json:"fields"`
+	} `json:"error"`
+}
+
+// seedCatalog creates one of each row a product points at, and a product,
+// through the API.
+func seedCatalog(t *testing.T, router http.Handler) products.Product {
+	t.Helper()
+	for _, create := range []struct{ path, body string }{
+		{"/brand/create", `{"brand":"CeraVe"}`},
+		{"/concerns/create", `{"concern":"Acne"}`},
+		{"/skin_type/create", `{"skin_type":"Oily"}`},
+		{"/product_type/create", `{"product_type":"Cleanser"}`},
+		{"/key_ingredients/create", `{"ingredient":"Salicylic Acid"}`},
+		{"/ingredient_synonyms/create", `{"synonym":"Aqua","canonical":"Water"}`},
+	} {
+		if w := serve(router, http.MethodPost, create.path, create.body); w.Code != http.StatusCreated {
+			t.Fatalf("POST %s = %d: %s", create.path, w.Code, w.Body.String())
+		}
+	}
+	var product products.Product
+	decode(t, serve(router, http.MethodPost, "/products/create", `{
+		"product_name": "SA Cleanser",
+		"all_ingredients": "AQUA, Salicylic Acid 2%, Glycerin",
+		"product_url": "https://example.com/sa",
+		"image_url": "https://example.com/sa.png",
+		"concern_id": 1, "skin_type_id": 1, "brand_id": 1, "product_type_id": 1, "key_ingredients_id": 1
+	}`), http.StatusCreated, &product)
+	return product
+}
+
+func TestHealth(t *testing.T) {
+	if w := serve(newTestRouter(), http.MethodGet, "/health", ""); w.Code != http.StatusOK {
+		t.Errorf("status = %d; want 200", w.Code)
+	}
+	down := newRouter(newMemoryStores(), func() error { return errors.New("unreachable") })
+	if w := serve(down, http.MethodGet, "/health", ""); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status with the database down = %d; want 503", w.Code)
+	}
+}
+
+func TestUnknownRoutesUseErrorEnvelope(t *testing.T) {
+	router := newTestRouter()
+	var body errorBody
+	w := serve(router, http.MethodGet, "/nowhere", "")
+	decode(t, w, http.StatusNotFound, &body)
+	if body.Error.Code == "" || w.Header().Get("X-Request-ID") == "" {
+		t.Errorf("404 = %s with request ID %q; want an error code and an ID", w.Body.String(), w.Header().Get("X-Request-ID"))
+	}
+	decode(t, serve(router, http.MethodDelete, "/brand", ""), http.StatusMethodNotAllowed, &body)
+}
+
+func TestBrandCRUD(t *testing.T) {
+	router := newTestRouter()
+	created := serve(router, http.MethodPost, "/brand/create", `{"brand":"CeraVe"}`)
+	if created.Code != http.StatusCreated || created.Header().Get("Location") != "/brand/1" {
+		t.Fatalf("create = %d at %q; want 201 at /brand/1", created.Code, created.Header().Get("Location"))
+	}
+
+	var brand struct {
+		BrandID int    `json:"brand_id"`
+		Brand   string `json:"brand"`
+	}
+	decode(t, serve(router, http.MethodPatch, "/brand/1", `{"brand":"The Ordinary"}`), http.StatusOK, &brand)
+	if brand.Brand != "The Ordinary" {
+		t.Errorf("patched brand = %q", brand.Brand)
+	}
+	decode(t, serve(router, http.MethodPut, "/brand/update", `{"brand_id":1,"brand":"Cosrx"}`), http.StatusOK, &brand)
+	decode(t, serve(router, http.MethodGet, "/brand/1", ""), http.StatusOK, &brand)
+	if brand.Brand != "Cosrx" {
+		t.Errorf("brand after update = %q; want Cosrx", brand.Brand)
+	}
+
+	if w := serve(router, http.MethodDelete, "/brand/delete/1", ""); w.Code != http.StatusOK {
+		t.Errorf("delete = %d; want 200", w.Code)
+	}
+	var missing errorBody
+	decode(t, serve(router, http.MethodGet, "/brand/1", ""), http.StatusNotFound, &missing)
+	decode(t, serve(router, http.MethodGet, "/brand/x", ""), http.StatusBadRequest, &missing)
+}
+
+func TestCreateValidates(t *testing.T) {
+	router := newTestRouter()
+	var body errorBody
+	decode(t, serve(router, http.MethodPost, "/brand/create", `{"brand":""}`), http.StatusUnprocessableEntity, &body)
+	decode(t, serve(router, http.MethodPost, "/products/create", `{"product_name":"X","all_ingredients":"Water",
+		"concern_id":1,"skin_type_id":1,"brand_id":1,"product_type_id":1,"key_ingredients_id":1}`),
+		http.StatusUnprocessableEntity, &body)
+	fields := map[string]bool{}
+	for _, field := range body.Error.Fields {
+		fields[field.Field] = true
+	}
+	for _, field := range []string{"concern_id", "skin_type_id", "brand_id", "product_type_id", "key_ingredients_id"} {
+		if !fields[field] {
+			t.Errorf("fields = %v; want %s reported as a missing reference", body.Error.Fields, field)
+		}
+	}
+	decode(t, serve(router, http.MethodPost, "/brand/create", `{"brand":`), http.StatusBadRequest, &body)
+}
+
+func TestIdempotentCreate(t *testing.T) {
+	router := newTestRouter()
+	first := serve(router, http.MethodPost, "/brand/create", `{"brand":"CeraVe"}`, idempotency.Header, "abc")
+	second := serve(router, http.MethodPost, "/brand/create", `{"brand":"CeraVe"}`, idempotency.Header, "abc")
+	if second.Code != http.StatusCreated || second.Header().Get(idempotency.ReplayedHeader) != "true" || second.Body.String() != first.Body.String() {
+		t.Errorf("retry = %d %q; want a replay of the first response", second.Code, second.Body.String())
+	}
+	var brands []interface{}
+	decode(t, serve(router, http.MethodGet, "/brand", ""), http.StatusOK, &brands)
+	if len(brands) != 1 {
+		t.Errorf("%d brands; want 1 after a retried create", len(brands))
+	}
+}
+
+func TestProducts(t *testing.T) {
+	router := newTestRouter()
+	created := seedCatalog(t, router)
+	if created.Brand != "CeraVe" || created.Concern != "Acne" || created.KeyIngredients != "Salicylic Acid" {
+		t.Errorf("created product names = %q, %q, %q; want them filled in", created.Brand, created.Concern, created.KeyIngredients)
+	}
+
+	// Without paging parameters the listing is a bare array
+	var all []products.Product
+	decode(t, serve(router, http.MethodGet, "/products", ""), http.StatusOK, &all)
+	if len(all) != 1 || all[0].ProductName != "SA Cleanser" {
+		t.Fatalf("GET /products = %+v; want the one product", all)
+	}
+	var page struct {
+		Data []products.Product `json:"data"`
+		Meta struct {
+			Limit int `json:"limit"`
+			Total int `json:"total"`
+		} `json:"meta"`
+	}
+	decode(t, serve(router, http.MethodGet, "/products?limit=5", ""), http.StatusOK, &page)
+	if len(page.Data) != 1 || page.Data[0].Brand != "CeraVe" || page.Meta.Limit != 5 || page.Meta.Total != 1 {
+		t.Fatalf("GET /products?limit=5 = %+v; want the one named product in a page of 5", page)
+	}
+	var body errorBody
+	decode(t, serve(router, http.MethodGet, "/products?limit=0", ""), http.StatusBadRequest, &body)
+
+	var parsed struct {
+		Data []products.Ingredient `json:"data"`
+	}
+	decode(t, serve(router, http.MethodGet, "/products/1/ingredients", ""), http.StatusOK, &parsed)
+	if ingredients := parsed.Data; len(ingredients) != 3 || ingredients[0].Ingredient != "Water" || ingredients[1].Concentration == nil || *ingredients[1].Concentration != 2 {
+		t.Errorf("ingredients = %+v; want Water, Salicylic Acid at 2%% and Glycerin", parsed.Data)
+	}
+
+	decode(t, serve(router, http.MethodGet, "/products?exclude_ingredient=water&limit=5", ""), http.StatusOK, &page)
+	if len(page.Data) != 0 {
+		t.Errorf("excluding water found %d products; want none", len(page.Data))
+	}
+
+	var selected []products.Product
+	decode(t, serve(router, http.MethodGet, "/products/select/1/1", ""), http.StatusOK, &selected)
+	if len(selected) != 1 || selected[0].Brand != "CeraVe" || selected[0].KeyIngredients != "Salicylic Acid" {
+		t.Errorf("selected = %+v; want the product with its names filled in", selected)
+	}
+	decode(t, serve(router, http.MethodGet, "/products/selectspec/1/1/2", ""), http.StatusOK, &selected)
+	if len(selected) != 0 {
+		t.Errorf("selecting another product type found %d products; want none", len(selected))
+	}
+	decode(t, serve(router, http.MethodGet, "/products/select/x/1", ""), http.StatusBadRequest, &body)
+
+	if w := serve(router, http.MethodDelete, "/products/delete/1", ""); w.Code != http.StatusOK {
+		t.Errorf("delete = %d; want 200", w.Code)
+	}
+	decode(t, serve(router, http.MethodGet, "/products/1", ""), http.StatusNotFound, &body)
+}
+
+func TestNormalizeNames(t *testing.T) {
+	router := newTestRouter()
+	seedCatalog(t, router)
+	var body struct {
+		Data []struct {
+			Name  string `json:"name"`
+			Match string `json:"match"`
+		} `json:"data"`
+	}
+	decode(t, serve(router, http.MethodGet, "/ingredient_synonyms/normalize?name=aqua&name=Retinal", ""), http.StatusOK, &body)
+	if len(body.Data) != 2 || body.Data[0].Name != "Water" || body.Data[1].Match != "none" {
+		t.Errorf("normalized = %+v; want Water, then Retinal unmatched", body.Data)
+	}
+}
+
+func TestRecommendations(t *testing.T) {
+	router := newTestRouter()
+	seedCatalog(t, router)
+	if w := serve(router, http.MethodPost, "/condition_concerns/create", `{"label":"acne","concern_id":1}`); w.Code != http.StatusCreated {
+		t.Fatalf("creating the condition = %d: %s", w.Code, w.Body.String())
+	}
+	var body struct {
+		Data []struct {
+			ProductID  int      `json:"product_id"`
+			Conditions []string `json:"conditions"`
+		} `json:"data"`
+		Meta struct {
+			Total int `json:"total"`
+		} `json:"meta"`
+		UnmatchedLabels []string `json:"unmatched_labels"`
+	}
+	decode(t, serve(router, http.MethodPost, "/recommendations",
+		`{"conditions":[{"label":"Acne","confidence":0.9},{"label":"freckles"}],"skin_type_id":1}`), http.StatusOK, &body)
+	if len(body.Data) != 1 || body.Data[0].ProductID != 1 || body.Meta.Total != 1 {
+		t.Errorf("recommendations = %+v; want product 1", body)
+	}
+	if len(body.UnmatchedLabels) != 1 || body.UnmatchedLabels[0] != "freckles" {
+		t.Errorf("unmatched labels = %q; want freckles", body.UnmatchedLabels)
+	}
+	var invalid errorBody
+	decode(t, serve(router, http.MethodPost, "/recommendations", `{"conditions":[],"skin_type_id":1}`), http.StatusUnprocessableEntity, &invalid)
+}
+
+func TestSimilarProducts(t *testing.T) {
+	router := newTestRouter()
+	seedCatalog(t, router)
+	for _, create := range []struct{ path, body string }{
+		{"/concerns/create", `{"concern":"Dryness"}`},
+		{"/product_type/create", `{"product_type":"Moisturizer","step_order":3}`},
+		// Same type as the seeded cleanser
+		{"/products/create", `{"product_name":"Gentle Cleanser","all_ingredients":"Water, Glycerin",
+			"concern_id":2,"skin_type_id":1,"brand_id":1,"product_type_id":1,"key_ingredients_id":1}`},
+		// Only glycerin in common, with neither type, concern nor an active shared
+		{"/products/create", `{"product_name":"Cream","all_ingredients":"Water, Glycerin, Shea Butter",
+			"concern_id":2,"skin_type_id":1,"brand_id":1,"product_type_id":2,"key_ingredients_id":1}`},
+	} {
+		if w := serve(router, http.MethodPost, create.path, create.body); w.Code != http.StatusCreated {
+			t.Fatalf("POST %s = %d: %s", create.path, w.Code, w.Body.String())
+		}
+	}
+	var body struct {
+		Data []struct {
+			ProductID       int    `json:"product_id"`
+			Brand           string `json:"brand"`
+			SameProductType bool   `json:"same_product_type"`
+		} `json:"data"`
+	}
+	decode(t, serve(router, http.MethodGet, "/products/1/similar", ""), http.StatusOK, &body)
+	if len(body.Data) != 1 || body.Data[0].ProductID != 2 || !body.Data[0].SameProductType || body.Data[0].Brand != "CeraVe" {
+		t.Errorf("similar = %+v; want only the named cleanser of the same type", body.Data)
+	}
+}
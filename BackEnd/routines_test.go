@@ -0,0 +1,130 @@
+package main
+
+import (
+	"BackEnd/Products"
+	"BackEnd/Routine"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// newTestSQLRouter serves the full API from a freshly migrated SQLite
+// database holding the seeded catalog.
+func newTestSQLRouter(t *testing.T) http.Handler {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	return newRouter(newTestSQLStores(t), func() error { return nil })
+}
+
+func TestCheckMatchesVitaminCSpellings(t *testing.T) {
+	router := newTestSQLRouter(t)
+	// Product 1 is a benzoyl peroxide cleanser and product 33 a serum listing
+	// 3-O-Ethyl Ascorbic Acid with Vitamin - C as its key ingredient
+	var body struct {
+		Data []routine.Interaction `json:"data"`
+	}
+	decode(t, serve(router, http.MethodPost, "/routines/check", `{"product_ids":[1,33]}`), http.StatusOK, &body)
+	if len(body.Data) == 0 || body.Data[0].Severity != "conflict" {
+		t.Fatalf("interactions = %+v; want benzoyl peroxide and vitamin C to conflict", body.Data)
+	}
+	if got := body.Data[0].Ingredients; got != [2]string{"Benzoyl Peroxide", "Ascorbic Acid"} {
+		t.Errorf("conflicting ingredients = %q; want Benzoyl Peroxide and Ascorbic Acid", got)
+	}
+}
+
+func TestBuildRoutine(t *testing.T) {
+	router := newTestSQLRouter(t)
+	// A concern and skin type of their own keep the seeded products out
+	var concern struct {
+		ConcernID int `json:"concern_id"`
+	}
+	decode(t, serve(router, http.MethodPost, "/concerns/create", `{"concern":"Rosacea"}`), http.StatusCreated, &concern)
+	var skinType struct {
+		SkinTypeID int `json:"skin_type_id"`
+	}
+	decode(t, serve(router, http.MethodPost, "/skin_type/create", `{"skin_type":"Mature"}`), http.StatusCreated, &skinType)
+	// Product types run cleanser 1, toner 3, serum 2, moisturiser 4 and
+	// sunscreen 5, which is kept to the morning; key ingredient 7, retinol,
+	// is kept to the evening
+	ids := make(map[string]int)
+	for _, product := range []struct {
+		name, ingredients          string
+		productType, keyIngredient int
+	}{
+		{"BP Wash", "Water, Benzoyl Peroxide 5%", 1, 8},
+		{"C Serum", "Water, Ascorbic Acid 10%", 2, 5},
+		{"B3 Serum", "Water, Niacinamide 5%", 2, 2},
+		{"Night Serum", "Water, Retinol 0.5%", 2, 7},
+		{"Lotion", "Water, Glycerin, Sodium Hyaluronate", 4, 4},
+		{"SPF 50", "Water, Zinc Oxide", 5, 9},
+	} {
+		var created struct {
+			ProductID int `json:"product_id"`
+		}
+		decode(t, serve(router, http.MethodPost, "/products/create", fmt.Sprintf(`{"product_name":%q,"all_ingredients":%q,
+			"concern_id":%d,"skin_type_id":%d,"brand_id":1,"product_type_id":%d,"key_ingredients_id":%d}`,
+			product.name, product.ingredients, concern.ConcernID, skinType.SkinTypeID, product.productType, product.keyIngredient)),
+			http.StatusCreated, &created)
+		ids[product.name] = created.ProductID
+	}
+
+	var body struct {
+		Data routine.Routine `json:"data"`
+	}
+	decode(t, serve(router, http.MethodGet, fmt.Sprintf("/routines/build?concern=%d&skin_type=%d&alternates=5",
+		concern.ConcernID, skinType.SkinTypeID), ""), http.StatusOK, &body)
+	built := body.Data
+
+	if got := stepTypes(built.AM); !reflect.DeepEqual(got, []int{1, 3, 2, 4, 5}) {
+		t.Errorf("AM product types = %v; want steps 1, 3, 2, 4, 5 in step order", got)
+	}
+	if got := stepTypes(built.PM); !reflect.DeepEqual(got, []int{1, 3, 2, 4}) {
+		t.Errorf("PM product types = %v; want the steps without sunscreen", got)
+	}
+	for i, step := range built.AM {
+		if step.Step != i+1 {
+			t.Errorf("AM step %d numbered %d", i+1, step.Step)
+		}
+	}
+	if product := built.AM[4].Product; product == nil || product.ProductID != ids["SPF 50"] {
+		t.Errorf("AM sunscreen = %+v; want SPF 50", product)
+	}
+
+	// The vitamin C serum conflicts with the benzoyl peroxide wash, so it
+	// only stands in as an alternate; retinol stays out of the morning
+	amSerum, pmSerum := built.AM[2], built.PM[2]
+	if amSerum.Product == nil || amSerum.Product.ProductID != ids["B3 Serum"] {
+		t.Errorf("AM serum = %+v; want B3 Serum over the conflicting C Serum", amSerum.Product)
+	}
+	if got := productIDs(amSerum.Alternates); !reflect.DeepEqual(got, []int{ids["C Serum"]}) {
+		t.Errorf("AM serum alternates = %v; want only C Serum", got)
+	}
+	// Retinol conflicts with benzoyl peroxide too, so both follow B3 Serum
+	if pmSerum.Product == nil || pmSerum.Product.ProductID != ids["B3 Serum"] {
+		t.Errorf("PM serum = %+v; want B3 Serum over the conflicting ones", pmSerum.Product)
+	}
+	if got := productIDs(pmSerum.Alternates); !reflect.DeepEqual(got, []int{ids["C Serum"], ids["Night Serum"]}) {
+		t.Errorf("PM serum alternates = %v; want C Serum and Night Serum", got)
+	}
+	if len(built.Interactions.AM) != 0 {
+		t.Errorf("AM interactions = %+v; want none once the conflict is avoided", built.Interactions.AM)
+	}
+}
+
+func stepTypes(steps []routine.Step) []int {
+	types := make([]int, len(steps))
+	for i, step := range steps {
+		types[i] = step.ProductTypeID
+	}
+	return types
+}
+
+func productIDs(list []products.Product) []int {
+	ids := make([]int, len(list))
+	for i, product := range list {
+		ids[i] = product.ProductID
+	}
+	return ids
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"BackEnd/Key_Ingredients"
+	"BackEnd/Products"
+	"reflect"
+	"testing"
+)
+
+// searchIDs returns the IDs of every product matching terms, best first.
+func searchIDs(t *testing.T, stores Stores, terms ...string) []int {
+	t.Helper()
+	matches, _, err := stores.Products.Search(products.SearchQuery{Terms: terms, Limit: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]int, len(matches))
+	for i, match := range matches {
+		ids[i] = match.Product.ProductID
+	}
+	return ids
+}
+
+func TestSearchFindsEveryLinkedKeyIngredient(t *testing.T) {
+	for name, newStores := range map[string]func(*testing.T) Stores{"sql": newTestSQLStores, "memory": newTestMemoryStores} {
+		t.Run(name, func(t *testing.T) {
+			stores := newStores(t)
+			primary, err := stores.KeyIngredients.Create(key_ingredients.KeyIngredients{KeyIngredient: "Ceramide NP"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			linked, err := stores.KeyIngredients.Create(key_ingredients.KeyIngredients{KeyIngredient: "Bakuchiol"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			product, err := stores.Products.Create(products.Product{
+				ProductName:      "Night Cream",
+				AllIngredients:   "Water, Glycerin",
+				ConcernID:        1,
+				SkinTypeID:       1,
+				BrandID:          1,
+				ProductTypeID:    1,
+				KeyIngredientsID: primary.KeyIngredientsID,
+				KeyIngredientIDs: []int{primary.KeyIngredientsID, linked.KeyIngredientsID},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ids := searchIDs(t, stores, "bakuchiol"); len(ids) != 1 || ids[0] != product.ProductID {
+				t.Errorf("searching a linked key ingredient found %v; want product %d", ids, product.ProductID)
+			}
+
+			linked.KeyIngredient = "Bidens Pilosa"
+			if _, err := stores.KeyIngredients.Update(linked); err != nil {
+				t.Fatal(err)
+			}
+			if ids := searchIDs(t, stores, "pilosa"); len(ids) != 1 || ids[0] != product.ProductID {
+				t.Errorf("searching a renamed key ingredient found %v; want product %d", ids, product.ProductID)
+			}
+
+			product.KeyIngredientIDs = []int{primary.KeyIngredientsID}
+			if _, err := stores.Products.Update(product); err != nil {
+				t.Fatal(err)
+			}
+			if ids := searchIDs(t, stores, "pilosa"); len(ids) != 0 {
+				t.Errorf("searching an unlinked key ingredient found %v; want nothing", ids)
+			}
+			if ids := searchIDs(t, stores, "ceramide"); !containsID(ids, product.ProductID) {
+				t.Errorf("searching the primary key ingredient found %v; want product %d among them", ids, product.ProductID)
+			}
+		})
+	}
+}
+
+func containsID(ids []int, want int) bool {
+	for _, id := range ids {
+		if id == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSearchRanksAndPages(t *testing.T) {
+	for name, newStores := range map[string]func(*testing.T) Stores{"sql": newTestSQLStores, "memory": newTestMemoryStores} {
+		t.Run(name, func(t *testing.T) {
+			stores := newStores(t)
+			var created []int
+			for _, product := range []products.Product{
+				{ProductName: "Daily Lotion", AllIngredients: "Water, Glycerin, Marula Oil"},
+				{ProductName: "Marula Face Oil", AllIngredients: "Marula Oil"},
+			} {
+				product.ConcernID, product.SkinTypeID, product.BrandID, product.ProductTypeID, product.KeyIngredientsID = 1, 1, 1, 1, 1
+				product.KeyIngredientIDs = []int{1}
+				product, err := stores.Products.Create(product)
+				if err != nil {
+					t.Fatal(err)
+				}
+				created = append(created, product.ProductID)
+			}
+			lotion, faceOil := created[0], created[1]
+
+			// A match in the name outranks one only in the ingredient list,
+			// and a term matches the words it starts
+			if ids := searchIDs(t, stores, "maru"); !reflect.DeepEqual(ids, []int{faceOil, lotion}) {
+				t.Errorf("searching maru found %v; want %v", ids, []int{faceOil, lotion})
+			}
+			matches, total, err := stores.Products.Search(products.SearchQuery{Terms: []string{"marula"}, Offset: 1, Limit: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 2 || len(matches) != 1 || matches[0].Product.ProductID != lotion {
+				t.Errorf("second page = %d matches of %d; want the lotion of 2", len(matches), total)
+			}
+			if matches, _, err = stores.Products.Search(products.SearchQuery{Terms: []string{"marula"}, Offset: 2, Limit: 1}); err != nil || len(matches) != 0 {
+				t.Errorf("page past the end = %d matches (%v); want none", len(matches), err)
+			}
+		})
+	}
+}
@@ -1,2 +0,0 @@
-USE Skinalyze;
-DROP DATABASE Skinalyze;
\ No newline at end of file